@@ -3,9 +3,35 @@ set -u
 set -o pipefail
 
 DEST_FILE=src/teslacoil.ts
-node node_modules/restful-react/dist/bin/restful-react.js import --file teslacoil.full-openapi.json \
-    --output $DEST_FILE --skip-react
+SPEC_FILE=teslacoil.full-openapi.json
 
-echo -e "/* eslint-disable */
+TMP_DIR=$(mktemp -d)
+trap 'rm -rf "$TMP_DIR"' EXIT
 
-$(cat $DEST_FILE)" > $DEST_FILE
\ No newline at end of file
+# Generate from the committed snapshot of the specification, so the generated
+# code can be reproduced and reviewed. Set OPENAPI_URL to update the snapshot
+# from a deployment first, e.g. https://api.teslacoil.io/openapi.json
+if [ -n "${OPENAPI_URL:-}" ]; then
+    curl --fail --silent --show-error "$OPENAPI_URL" --output "$TMP_DIR/spec.json"
+    cp "$TMP_DIR/spec.json" "$SPEC_FILE"
+fi
+OPENAPI_FILE=${OPENAPI_FILE:-$SPEC_FILE}
+
+node node_modules/restful-react/dist/bin/restful-react.js import --file "$OPENAPI_FILE" \
+    --output "$TMP_DIR/teslacoil.ts" --skip-react
+
+# Everything above the marker in src/teslacoil.ts, like buildURL and
+# TeslacoilError, is maintained by hand, so keep it instead of what the
+# generator emits. The generated types start at the first doc comment or type
+# declaration, as the generator's own helpers have neither.
+MARKER='// Everything below is generated from teslacoil.full-openapi.json by yarn gen-code'
+if ! grep -qxF "$MARKER" "$DEST_FILE"; then
+    echo "missing generated code marker in $DEST_FILE" >&2
+    exit 1
+fi
+{
+    sed -n "1,\|^$MARKER\$|p" "$DEST_FILE"
+    awk 'found || /^(\/\*\*|export (interface|type) )/ { found = 1; print }' "$TMP_DIR/teslacoil.ts" |
+        sed 's/throw Error(error)/throw new TeslacoilError(error)/'
+} > "$TMP_DIR/merged.ts"
+mv "$TMP_DIR/merged.ts" "$DEST_FILE"
@@ -6,9 +6,21 @@ import { AxiosInstance } from 'axios'
 
 let api: AxiosInstance
 
+// The readiness check responds with 503 Service Unavailable if a dependency is
+// unhealthy, and the body still has the status of each dependency
+const acceptUnready = (error: any): any => {
+  const response = error && error.response
+  if (response && response.status === 503 && error.config.url === '/v0/system/health/ready') {
+    return response
+  }
+  return Promise.reject(error)
+}
+
 export const setCredentials = (axiosAPI: AxiosInstance, apiURL: string, apiKey?: string, timeout?: number): void => {
   api = axiosAPI
 
+  api.interceptors.response.use(undefined, acceptUnready)
+
   api.defaults.baseURL = apiURL
 
   if (timeout) {
@@ -20,12 +32,19 @@ export const setCredentials = (axiosAPI: AxiosInstance, apiURL: string, apiKey?:
   }
 }
 
+const encodeParam = (value: any): string => {
+  if (Array.isArray(value)) {
+    return value.map(element => encodeURIComponent(String(element))).join(',')
+  }
+  return encodeURIComponent(String(value))
+}
+
 const buildURL = (route: string, ...args: [string, any][]): string => {
   let url = route
   let params = ''
   args.forEach(arg => {
     const name = arg[0]
-    const value = arg[1]
+    const value = arg[1] && encodeParam(arg[1])
     if (value) {
       if (params === '') {
         params += `?${name}=${value}`
@@ -36,6 +55,31 @@ const buildURL = (route: string, ...args: [string, any][]): string => {
   })
   return `${url}${params}`
 }
+
+/**
+ * The error thrown when a request fails. Carries the error returned by the API,
+ * so the request ID can be read from error.error.request_id.
+ */
+export class TeslacoilError extends Error {
+  /**
+   * The HTTP status code of the response. Not set if no response was received,
+   * e.g. on network errors and timeouts.
+   */
+  status?: number
+  error?: RestErrorContent
+
+  constructor(error: any) {
+    super(String(error))
+    Object.setPrototypeOf(this, TeslacoilError.prototype)
+    const response = error && error.response
+    if (response) {
+      this.status = response.status
+      this.error = response.data && response.data.error
+    }
+  }
+}
+
+// Everything below is generated from teslacoil.full-openapi.json by yarn gen-code
 export interface Account {
   /**
    * (if not zero) How much wiggle room to give the invoice status.
@@ -55,6 +99,16 @@ export interface Account {
    * most expensive. Will not be higher than 1008.
    */
   default_conf_target?: number
+  /**
+   * Whether or not the monthly account statement is emailed to the account
+   * owner when it is ready.
+   */
+  email_monthly_statements?: boolean
+  /**
+   * The processing fees charged on payments settled to this account. If not
+   * set, the fee policy of the tenant is used. Only admins can change it.
+   */
+  fee_policy?: FeePolicy
   id?: string
   name?: string
   /**
@@ -64,7 +118,17 @@ export interface Account {
   onchain_invoice_confirmation_threshold?: number
   pending_balance_bitcoin?: number
   profile_picture?: string
+  /**
+   * Whether or not this account is a sandbox account. All requests made on
+   * behalf of a sandbox account are served by our testnet nodes. Set when the
+   * account is created.
+   */
+  sandbox?: boolean
   shopify_url?: string
+  /**
+   * The tenant this account belongs to.
+   */
+  tenant_id?: string
 }
 
 /**
@@ -106,11 +170,25 @@ export interface AccountUser {
   pending_balance_bitcoin: number
   permissions: Permissions
   shopify_url?: string
+  /**
+   * Whether or not this user is a support agent. Support agents can read the
+   * profile and transactions of any user through the support endpoints, but
+   * cannot move funds or change credentials. All such reads are recorded in
+   * the audit log. The role is granted and revoked by admins.
+   */
+  support: boolean
   update_time: string
   user_id: string
   user_preferred_display_currency: CryptoCurrencyFormat
 }
 
+/**
+ * - QUICKBOOKS_IIF: Intuit Interchange Format, for importing into QuickBooks
+ *  - XERO_CSV: CSV formatted for importing bank statements into Xero
+ *  - DOUBLE_ENTRY_CSV: Generic CSV with a debit and a credit line per transaction
+ */
+export type AccountingExportFormat = 'QUICKBOOKS_IIF' | 'XERO_CSV' | 'DOUBLE_ENTRY_CSV'
+
 export interface AccountingTransaction {
   complete_time?: string
   direction?: TransactionDirection
@@ -122,6 +200,10 @@ export interface AccountingTransaction {
   network_fee_milli_sat?: string
   network_type?: NetworkType
   outbound_milli_sat?: string
+  /**
+   * The processing fee deducted from this transaction when it was settled.
+   */
+  platform_fee_milli_sat?: string
   transaction_description?: string
 }
 
@@ -147,6 +229,50 @@ export interface AddOrUpdateShopifyIntegrationResponse {
  */
 export type AddressType = 'CURRENT' | 'LEGACY'
 
+export interface AdminStatsInterval {
+  median_settlement_latency_seconds: number
+  new_user_count: number
+  /**
+   * The start of this interval.
+   */
+  start_time: string
+  volume_lightning_satoshi: string
+  volume_onchain_satoshi: string
+  webhook_failure_rate: number
+}
+
+/**
+ * Aggregate statistics for the whole deployment, intended for operator
+ * dashboards.
+ */
+export interface AdminStatsResponse {
+  /**
+   * The combined local balance of all our Lightning channels, measured in
+   * satoshis.
+   */
+  channel_balance_satoshi: string
+  /**
+   * The confirmed on-chain balance of our hot wallet, measured in satoshis.
+   */
+  hot_wallet_balance_satoshi: string
+  /**
+   * The median time from an invoice being created until it is settled,
+   * measured in seconds.
+   */
+  median_settlement_latency_seconds: number
+  /**
+   * The statistics for each interval in the requested period.
+   */
+  time_series: AdminStatsInterval[]
+  user_count: number
+  volume_lightning_satoshi: string
+  volume_onchain_satoshi: string
+  /**
+   * The fraction of callbacks that could not be delivered, between 0 and 1.
+   */
+  webhook_failure_rate: number
+}
+
 /**
  * Response from the amount transacted endpoint.
  */
@@ -162,6 +288,11 @@ export interface ApiKey {
    * The description (if any) associated with this key.
    */
   description: string
+  /**
+   * How many requests made with this key in the last 30 days resulted in an
+   * error.
+   */
+  error_count: number
   expiry_time?: string
   /**
    * The hashed API key. API keys are not stored in cleartext in the database,
@@ -176,9 +307,195 @@ export interface ApiKey {
   last_letters: string
   last_use_time?: string
   permissions: Permissions
+  /**
+   * How many requests were made with this key in the last 30 days.
+   */
+  request_count: number
+  /**
+   * Whether or not this is a sandbox key. Requests made with a sandbox key are
+   * served by our testnet nodes, and never touch real funds or the balance of
+   * the account on mainnet.
+   */
+  sandbox: boolean
+  /**
+   * The IP addresses and CIDR ranges this key can be used from. If empty, the
+   * key can be used from anywhere.
+   */
   whitelisted_ips: string[]
 }
 
+export interface ApiKeyEndpointUsage {
+  /**
+   * The method and path of the endpoint, e.g. "POST /v0/invoices".
+   */
+  endpoint: string
+  error_count: number
+  request_count: number
+}
+
+/**
+ * How an API key has been used in a period.
+ */
+export interface ApiKeyUsage {
+  endpoints: ApiKeyEndpointUsage[]
+  /**
+   * How many requests made with this key resulted in an error.
+   */
+  error_count: number
+  hashed_key: string
+  last_use_time?: string
+  /**
+   * How many requests were made with this key.
+   */
+  request_count: number
+}
+
+export interface ApproveWithdrawalRequest {
+  /**
+   * Whether to approve or reject the withdrawal. Rejected withdrawals fail,
+   * and the amount is returned to the account balance.
+   */
+  approve: boolean
+  /**
+   * The Teslacoil ID of the withdrawal waiting for approval. This is a
+   * required field.
+   */
+  id: string
+}
+
+/**
+ * - LOGIN: A user signed in, or tried to
+ *  - PASSWORD_CHANGE: A password was changed or reset
+ *  - TWO_FACTOR_CHANGE: 2FA was enabled or disabled
+ *  - API_KEY_CREATE: An API key was created
+ *  - API_KEY_DELETE: An API key was deleted
+ *  - WITHDRAWAL: Funds were sent from the account
+ *  - ADMIN_ACTION: An admin performed an action on the account
+ */
+export type AuditAction =
+  | 'LOGIN'
+  | 'PASSWORD_CHANGE'
+  | 'TWO_FACTOR_CHANGE'
+  | 'API_KEY_CREATE'
+  | 'API_KEY_DELETE'
+  | 'WITHDRAWAL'
+  | 'ADMIN_ACTION'
+
+/**
+ * A snapshot of a row before and after it was changed.
+ */
+export interface AuditChange {
+  /**
+   * The row after the change. Not set if the row was deleted.
+   */
+  after?: { [key: string]: any }
+  /**
+   * The row before the change. Not set if the row was inserted.
+   */
+  before?: { [key: string]: any }
+  change_time: string
+  entity_id: string
+  entity_type: AuditEntityType
+  id: string
+  operation: AuditOperation
+}
+
+export type AuditEntityType = 'TRANSACTION' | 'USER' | 'API_KEY'
+
+/**
+ * A security relevant action performed by or on behalf of a user.
+ */
+export interface AuditLogEntry {
+  action: AuditAction
+  /**
+   * The user that performed the action.
+   */
+  actor_user_id: string
+  create_time: string
+  /**
+   * Additional information about the action, e.g. the hash of a created API
+   * key.
+   */
+  details?: { [key: string]: any }
+  id: string
+  ip_address: string
+  outcome: AuditOutcome
+  user_agent: string
+}
+
+export type AuditOperation = 'INSERT' | 'UPDATE' | 'DELETE'
+
+export type AuditOutcome = 'SUCCESS' | 'FAILURE'
+
+/**
+ * @deprecated Use Confirm2faResponse instead.
+ */
+export type AuthenticationConfirm2faResponse = Confirm2faResponse
+
+/**
+ * A monitored balance compared against its configured thresholds.
+ */
+export interface BalanceLevel {
+  balance_satoshi: string
+  /**
+   * The configured maximum for this balance, if any.
+   */
+  ceiling_satoshi?: string
+  /**
+   * The configured minimum for this balance, if any.
+   */
+  floor_satoshi?: string
+  status: BalanceLevelStatus
+}
+
+/**
+ * - OK: The balance is within its thresholds
+ *  - BELOW_FLOOR: The balance is below its configured minimum
+ *  - ABOVE_CEILING: The balance is above its configured maximum
+ */
+export type BalanceLevelStatus = 'OK' | 'BELOW_FLOOR' | 'ABOVE_CEILING'
+
+export interface BalanceLevelsResponse {
+  /**
+   * The combined inbound capacity of our Lightning channels.
+   */
+  channel_inbound: BalanceLevel
+  /**
+   * The combined outbound capacity of our Lightning channels.
+   */
+  channel_outbound: BalanceLevel
+  /**
+   * The confirmed on-chain balance of our hot wallet.
+   */
+  hot_wallet: BalanceLevel
+  /**
+   * The hot wallet balance plus the outbound channel capacity, divided by the
+   * total of user balances. Below 1, not every user balance can be paid out at
+   * once.
+   */
+  liquidity_ratio: number
+  /**
+   * The configured minimum for the liquidity ratio, if any. Operators are
+   * alerted when the ratio falls below it.
+   */
+  liquidity_ratio_floor?: number
+  /**
+   * The sum of the balances of all users.
+   */
+  user_balances_satoshi: string
+}
+
+export interface BeginWebAuthnLoginRequest {
+  /**
+   * The email of the user that's signing in. This is a required field.
+   */
+  email: string
+  /**
+   * The password of the user that's signing in. This is a required field.
+   */
+  password: string
+}
+
 export interface BitcoinPrice {
   /**
    * The price of 1 BTC, expressed in USD.
@@ -190,6 +507,14 @@ export interface BitcoinPrice {
   price_timestamp?: string
 }
 
+export interface BlockchainFeeEstimate {
+  sats_per_byte: number
+  /**
+   * The number of blocks the transaction is expected to confirm within.
+   */
+  target: number
+}
+
 export interface BlockchainTransaction {
   confirmations?: number
   confirmed_block_hash?: string
@@ -203,6 +528,83 @@ export interface BlockchainTransaction {
   sent_time?: string
 }
 
+/**
+ * An address that withdrawals can not be sent to and deposits are not credited
+ * from, e.g. because it is on a sanctions list.
+ */
+export interface BlocklistEntry {
+  /**
+   * The bitcoin address or Lightning node public key that is blocked.
+   */
+  address: string
+  create_time: string
+  id: string
+  /**
+   * Why the address is blocked.
+   */
+  reason?: string
+  /**
+   * The list the entry was imported from, e.g. OFAC-SDN.
+   */
+  source: string
+}
+
+export interface BumpDepositRequest {
+  /**
+   * The fee rate the parent and child transactions should have together,
+   * measured in satoshi per (virtual) byte. This is a required field.
+   */
+  fee_satoshi_per_byte: number
+  /**
+   * The bitcoin transaction ID of the stuck deposit. This is a required
+   * field.
+   */
+  network_id: string
+  /**
+   * The output index of the deposit in the stuck transaction.
+   */
+  transaction_output: number
+}
+
+export interface BumpDepositResponse {
+  /**
+   * The bitcoin transaction ID of the child transaction spending the deposit.
+   */
+  child_network_id: string
+  /**
+   * The fee paid by the child transaction, measured in satoshis.
+   */
+  fee_satoshi: string
+}
+
+/**
+ * A single attempt at delivering a callback.
+ */
+export interface CallbackAttempt {
+  /**
+   * Which attempt this was for the callback, starting at 1.
+   */
+  attempt: number
+  create_time: string
+  /**
+   * Why the request failed, if it did not get a response, e.g. a timeout.
+   */
+  error?: string
+  /**
+   * How long the receiving server took to respond, measured in milliseconds.
+   */
+  latency_ms: number
+  /**
+   * The first 1 KB of the response body.
+   */
+  response_snippet: string
+  /**
+   * The HTTP status code of the response, if any.
+   */
+  status_code?: number
+  url: string
+}
+
 /**
  * Description of event that triggered callback
  */
@@ -234,6 +636,91 @@ export interface ChangePasswordRequest {
   repeated_new_password: string
 }
 
+/**
+ * An open Lightning channel of our node.
+ */
+export interface Channel {
+  /**
+   * Whether or not the peer is online, so the channel can be used.
+   */
+  active: boolean
+  capacity_satoshi: string
+  /**
+   * The funding outpoint of the channel, formatted as "<network ID>:<output
+   * index>".
+   */
+  channel_point: string
+  /**
+   * The short channel ID of the channel.
+   */
+  id: string
+  local_balance_satoshi: string
+  private: boolean
+  remote_balance_satoshi: string
+  remote_pubkey: string
+}
+
+/**
+ * The routing fees and time lock delta we advertise for a channel.
+ */
+export interface ChannelFeePolicy {
+  /**
+   * The fixed fee charged for every forwarded payment, measured in
+   * millisatoshis.
+   */
+  base_fee_milli_sat: string
+  /**
+   * The variable fee charged for forwarded payments, measured in parts per
+   * million of the forwarded amount.
+   */
+  fee_rate_ppm: string
+  /**
+   * The CLTV delta required for forwarded payments.
+   */
+  time_lock_delta: number
+}
+
+/**
+ * A rule deciding which fee policy applies to a channel. The first matching
+ * rule is used, and channels matching no rules get the default policy.
+ */
+export interface ChannelFeeRule {
+  /**
+   * Only match channels with at most this capacity.
+   */
+  max_capacity_satoshi?: string
+  /**
+   * Only match channels with at least this capacity.
+   */
+  min_capacity_satoshi?: string
+  /**
+   * Only match channels with this peer.
+   */
+  peer_pubkey?: string
+  policy: ChannelFeePolicy
+}
+
+export interface ChannelFeeRulesResponse {
+  default_policy: ChannelFeePolicy
+  /**
+   * When the fee policies were last applied to our channels.
+   */
+  last_update_time?: string
+  rules: ChannelFeeRule[]
+  /**
+   * How often fee policies are reapplied to our channels, measured in seconds.
+   */
+  update_interval_seconds: number
+}
+
+/**
+ * - DEFAULT: Let the node wallet select coins
+ *  - LARGEST_FIRST: Spend the largest coins first, to avoid using many inputs
+ *  - AVOID_CHANGE: Prefer a set of coins that does not require a change output
+ *  - MANUAL: Only spend the coins given in the request
+ */
+export type CoinSelectionStrategy = 'DEFAULT' | 'LARGEST_FIRST' | 'AVOID_CHANGE' | 'MANUAL'
+
 export interface Confirm2faRequest {
   /**
    * A 2FA code the user generated with their authenticator app. This is a
@@ -242,6 +729,15 @@ export interface Confirm2faRequest {
   code: string
 }
 
+export interface Confirm2faResponse {
+  /**
+   * Single-use codes that can be used instead of a 2FA code, if the user loses
+   * access to their authenticator app. They are only shown once, so the user
+   * should store them somewhere safe.
+   */
+  recovery_codes: string[]
+}
+
 export interface Create2faResponse {
   /**
    * A 2FA secret the user can input into their authenticator app to
@@ -252,6 +748,12 @@ export interface Create2faResponse {
 
 export interface CreateAccountRequest {
   name: string
+  /**
+   * If set, the created account is a sandbox account. Sandbox accounts let you
+   * develop against the API without using real funds. This can not be changed
+   * after the account is created.
+   */
+  sandbox?: boolean
 }
 
 export interface CreateApiKeyRequest {
@@ -262,6 +764,16 @@ export interface CreateApiKeyRequest {
   description?: string
   expiry_time?: string
   permissions?: Permissions
+  /**
+   * If set, the created key is a sandbox key. Sandbox keys let you develop
+   * against the API without using real funds.
+   */
+  sandbox?: boolean
+  /**
+   * The IP addresses and CIDR ranges, e.g. 203.0.113.0/24, this key can be used
+   * from. Requests from other addresses are rejected. If not set, the key can
+   * be used from anywhere.
+   */
   whitelisted_ips?: string[]
 }
 
@@ -286,6 +798,13 @@ export interface CreateInvoiceRequest {
    * This is a required field, and cannot be less than zero.
    */
   amount?: number
+  /**
+   * If set, a replacement invoice with the same client ID is created when this
+   * invoice expires without being paid. The replacement is linked to this
+   * invoice, and a callback is sent with the new invoice. Requires a callback
+   * URL.
+   */
+  auto_regenerate?: boolean
   /**
    * An (optional) callback URL to associate with this invoice. When the
    * invoice receives payment, we send a POST request to this URL to notify
@@ -310,6 +829,11 @@ export interface CreateInvoiceRequest {
    */
   description?: string
   exchange_currency?: FiatcurrencyFiatCurrency
+  /**
+   * If set, we send an "about to expire" callback this many seconds before the
+   * invoice expires, if it is still unpaid. Requires a callback URL.
+   */
+  expiry_reminder_seconds?: number
   /**
    * The expiry of this invoice. Defaults to 1 hour, if not
    * set or set to zero. It is still possible for the user to send money to the
@@ -323,6 +847,12 @@ export interface CreateInvoiceRequest {
    * on-chain invoice, setting this field has no effect.
    */
   lightning_memo?: string
+  /**
+   * Arbitrary JSON you want to associate with this invoice. It is returned
+   * as-is when retrieving the invoice, and included in callbacks. Cannot be
+   * larger than 4 KB when serialized.
+   */
+  metadata?: { [key: string]: any }
   /**
    * How many blockchain confirmations an on-chain transaction needs before it
    * counts toward the payment status of this invoice. If this is not set, it
@@ -340,10 +870,63 @@ export interface CreateInvoiceRequest {
   redirect_url?: string
 }
 
-/**
- * Possible parameters when creating a trade.
- */
-export interface CreateTradeRequest {
+export interface CreatePaymentRequestRequest {
+  /**
+   * The amount you want to request, measured in the currency you specify.
+   * This is a required field, and cannot be less than zero.
+   */
+  amount: number
+  /**
+   * An (optional) callback URL to associate with the invoice created for this
+   * payment request.
+   */
+  callback_url?: string
+  /**
+   * An (optional) ID to associate with the invoice created for this payment
+   * request.
+   */
+  client_id?: string
+  currency?: CurrencyCurrency
+  /**
+   * The email address the payment link is sent to. This is a required field.
+   */
+  customer_email: string
+  /**
+   * A description of what is being paid for. This is included in the email
+   * sent to the customer.
+   */
+  description?: string
+  /**
+   * How long the customer has to pay, measured in seconds. Defaults to 7 days.
+   */
+  expiry_seconds?: number
+}
+
+export interface CreateTenantRequest {
+  /**
+   * The domain the branded payment pages and API are served from, e.g.
+   * pay.example.com.
+   */
+  custom_domain?: string
+  /**
+   * The address transactional emails to users of this tenant are sent from.
+   */
+  email_from_address?: string
+  fee_policy?: FeePolicy
+  /**
+   * Logo for the tenant. Expects base64-encoded string.
+   */
+  logo?: string
+  /**
+   * The name of the tenant. This is a required field.
+   */
+  name: string
+}
+
+/**
+ * Possible parameters when creating a trade.
+ */
+export interface CreateTradeRequest {
   /**
    * How many units of the base currency you want to trade. Cannot be specified
    * together with amount quote.
@@ -366,6 +949,49 @@ export interface CreateTradeRequest {
   side: OrderSide
 }
 
+/**
+ * The available fields for creating a unified payment, which can be paid
+ * either on-chain or over Lightning.
+ */
+export interface CreateUnifiedPaymentRequest {
+  /**
+   * The amount you want the payment to be for, measured in the currency you
+   * specify. This is a required field, and cannot be less than zero.
+   */
+  amount: number
+  /**
+   * An (optional) callback URL to associate with this payment. When the
+   * payment is settled, we send a POST request to this URL to notify you.
+   */
+  callback_url?: string
+  /**
+   * An (optional) ID you can associate with this payment. Both the on-chain
+   * and the Lightning invoice created for this payment are tagged with it.
+   */
+  client_id?: string
+  currency?: CurrencyCurrency
+  /**
+   * An (optional) description to associate with this payment. This is only
+   * visible to the creator of the payment.
+   */
+  description?: string
+  /**
+   * The expiry of this payment. Defaults to 1 hour, if not set or set to zero.
+   */
+  expiry_seconds?: number
+  /**
+   * An optional description to encode into the Lightning request associated
+   * with this payment. This is publicly visible.
+   */
+  lightning_memo?: string
+  /**
+   * Arbitrary JSON you want to associate with this payment. It is returned
+   * as-is when retrieving the payment, and included in callbacks. Cannot be
+   * larger than 4 KB when serialized.
+   */
+  metadata?: { [key: string]: any }
+}
+
 export interface CreateUserRequest {
   /**
    * The email of the user you want to create. This is a required field. After
@@ -378,6 +1004,54 @@ export interface CreateUserRequest {
   email?: string
   first_name?: string
   last_name?: string
+  /**
+   * The language emails to the user are written in, as an IETF language tag.
+   * Defaults to "en".
+   */
+  locale?: string
+}
+
+export interface CreateWebhookEndpointRequest {
+  /**
+   * The URL callbacks are sent to. Callback URLs on invoices and transactions
+   * must match a registered endpoint to be signed with its secret. This is a
+   * required field.
+   */
+  url: string
+}
+
+export interface CreateWidgetConfigRequest {
+  /**
+   * The origins the widget is allowed to be embedded on, e.g.
+   * https://shop.example.com. This is a required field.
+   */
+  allowed_origins: string[]
+  /**
+   * The amount the widget should request, measured in the currency you
+   * specify. This is a required field, and cannot be less than zero.
+   */
+  amount: number
+  /**
+   * An (optional) callback URL to associate with invoices created through
+   * this widget.
+   */
+  callback_url?: string
+  /**
+   * An (optional) ID to associate with invoices created through this widget,
+   * typically your order ID.
+   */
+  client_id?: string
+  currency?: CurrencyCurrency
+  /**
+   * An (optional) description to associate with invoices created through this
+   * widget.
+   */
+  description?: string
+  /**
+   * How long the widget configuration is valid for, measured in seconds.
+   * Defaults to 15 minutes, and cannot be more than 1 hour.
+   */
+  expiry_seconds?: number
 }
 
 export interface CreatedInvoiceEvent {
@@ -456,6 +1130,33 @@ export interface CustomCategory {
   on_chain?: boolean
 }
 
+/**
+ * A job assembling all data we store about a user into a downloadable
+ * archive. When the archive is ready, a time-limited download link is emailed
+ * to the user.
+ */
+export interface DataExport {
+  create_time: string
+  /**
+   * When the archive was ready, if it is.
+   */
+  finish_time?: string
+  id: string
+  /**
+   * When the download link stops working, if the archive is ready.
+   */
+  link_expire_time?: string
+  status: DataExportStatus
+}
+
+/**
+ * - PENDING: The archive is being assembled
+ *  - READY: The archive is ready, and the download link is emailed
+ *  - EXPIRED: The download link has expired
+ *  - FAILED: The archive could not be assembled
+ */
+export type DataExportStatus = 'PENDING' | 'READY' | 'EXPIRED' | 'FAILED'
+
 export interface DecodeLightningResponse {
   amount_satoshi?: string
   destination?: string
@@ -468,6 +1169,81 @@ export interface DecodeLightningResponse {
   payment_hash?: string
 }
 
+export interface Delete2faRequest {
+  /**
+   * A 2FA code the user generated with their authenticator app. Either this or
+   * a recovery code is required.
+   */
+  code?: string
+  /**
+   * One of the recovery codes returned when 2FA was confirmed.
+   */
+  recovery_code?: string
+}
+
+export interface DeleteUserRequest {
+  /**
+   * The user's password. This is a required field.
+   */
+  password: string
+  /**
+   * A bitcoin address or Lightning request the remaining balance is sent to
+   * before the account is closed. If not set, accounts with a balance cannot
+   * be closed.
+   */
+  sweep_destination?: string
+  /**
+   * A 2FA code. If 2FA is enabled, this is a required field.
+   */
+  totp_code?: string
+}
+
+export interface DependencyHealth {
+  /**
+   * Why the dependency is unhealthy, if it is.
+   */
+  error?: string
+  healthy: boolean
+  /**
+   * The name of the dependency, e.g. "db", "bitcoind" or "lnd".
+   */
+  name: string
+}
+
+/**
+ * A dispute raised on a transaction by its owner.
+ */
+export interface Dispute {
+  create_time: string
+  /**
+   * The reason given when the transaction was flagged.
+   */
+  reason: string
+  /**
+   * A note from support explaining the resolution, if resolved.
+   */
+  resolution_note?: string
+  resolve_time?: string
+  status: DisputeStatus
+  transaction_id: string
+}
+
+/**
+ * - OPEN: The dispute is waiting to be reviewed by support
+ *  - ESCALATED: The dispute needs further investigation
+ *  - CREDITED: The dispute was resolved in favor of the account, and the
+ * account was credited
+ *  - REJECTED: The dispute was resolved without crediting the account
+ */
+export type DisputeStatus = 'OPEN' | 'ESCALATED' | 'CREDITED' | 'REJECTED'
+
+/**
+ * - QUEUED: The email is waiting to be sent, or to be retried
+ *  - SENT: The email was accepted by the email provider
+ *  - FAILED: The email could not be sent, and will not be retried
+ */
+export type EmailStatus = 'QUEUED' | 'SENT' | 'FAILED'
+
 /**
  *  - LOCAL_CHANNEL_OPEN: A channel opening transaction for a channel opened by our node.
  *  - REMOTE_CHANNEL_OPEN: A channel opening transaction for a channel opened by a remote node.
@@ -541,6 +1317,66 @@ export interface ExecuteRequest {
   payment_destination?: string
 }
 
+/**
+ * Processing fees charged on settled payments.
+ */
+export interface FeePolicy {
+  /**
+   * The variable part of the fee, measured in basis points (1/100 of a
+   * percent) of the settled amount.
+   */
+  basis_points?: number
+  /**
+   * The fixed part of the fee, measured in satoshis.
+   */
+  fixed_satoshi?: string
+}
+
+export interface FinishWebAuthnRegistrationRequest {
+  /**
+   * The public key credential created by the authenticator, as returned by
+   * navigator.credentials.create(). This is a required field.
+   */
+  credential: { [key: string]: any }
+  /**
+   * A name for the credential, e.g. "YubiKey". Makes it easier to identify a
+   * specific credential later on.
+   */
+  name?: string
+}
+
+export interface FlagTransactionRequest {
+  /**
+   * The Teslacoil ID of the transaction you want to dispute. This is a
+   * required field.
+   */
+  id: string
+  /**
+   * Why you are disputing this transaction. This is a required field.
+   */
+  reason: string
+}
+
+/**
+ * An overview of current fees, for showing costs before a withdrawal is made.
+ * Unlike the blockchain fee estimate, it covers several confirmation targets
+ * in one request, and the Lightning fee is estimated from an amount instead of
+ * a payment request. Use the Lightning fee estimate once you have the payment
+ * request, as it finds an actual route and is more precise.
+ */
+export interface GetFeesResponse {
+  /**
+   * Recommended on-chain fee rates for a range of confirmation targets,
+   * ordered by target.
+   */
+  blockchain: BlockchainFeeEstimate[]
+  /**
+   * The estimated routing fee for sending the requested amount over
+   * Lightning, measured in satoshis. Only set if an amount was given.
+   */
+  lightning_fee_satoshi?: string
+}
+
 export interface GetJwtRequest {
   /**
    * The ID or name of the account that the JWT will be valid for. If not set,
@@ -556,13 +1392,35 @@ export interface GetJwtRequest {
    */
   password: string
   /**
-   * The 2FA code to use when requesting a JWT. If 2FA is enabled, this is a
-   * required field.
+   * One of the recovery codes returned when 2FA was confirmed. Can be used
+   * instead of a 2FA code, but only once.
+   */
+  recovery_code?: string
+  /**
+   * The 2FA code to use when requesting a JWT. If 2FA is enabled, this or a
+   * WebAuthn assertion is a required field.
    */
   totp_code?: string
+  /**
+   * The assertion returned by navigator.credentials.get(), using the options
+   * from beginning a WebAuthn login. Can be used instead of a 2FA code if the
+   * user has registered a WebAuthn credential.
+   */
+  webauthn_assertion?: { [key: string]: any }
 }
 
 export interface GetJwtResponse {
+  /**
+   * When the created JWT expires. Access tokens are short-lived, so use the
+   * refresh token to get a new one before this time.
+   */
+  expire_time?: string
+  /**
+   * A long-lived token that can be exchanged for a new JWT. It can be revoked
+   * by logging out. Not set when refreshing with the old refresh JWT
+   * endpoint.
+   */
+  refresh_token?: string
   /**
    * The created JWT. This can be used to authenticate against the API, by
    * prefixing it with "Bearer " and placing in the authorization header.
@@ -575,6 +1433,50 @@ export interface GiveAccessRequest {
   user_id?: string
 }
 
+export interface HealthResponse {
+  /**
+   * The status of each dependency. Only set for the readiness check, which
+   * responds with 503 Service Unavailable and healthy set to false if any
+   * dependency is unhealthy.
+   */
+  dependencies?: DependencyHealth[]
+  healthy: boolean
+}
+
+export interface ImportBlocklistRequest {
+  /**
+   * The bitcoin addresses and Lightning node public keys to block. This is a
+   * required field.
+   */
+  addresses: string[]
+  /**
+   * Why the addresses are blocked.
+   */
+  reason?: string
+  /**
+   * If true, entries from the same source that are not in this import are
+   * removed, so a full list can be imported again when it is updated.
+   */
+  replace?: boolean
+  /**
+   * The list the addresses are imported from, e.g. OFAC-SDN. This is a
+   * required field.
+   */
+  source: string
+}
+
+export interface ImportBlocklistResponse {
+  /**
+   * How many addresses were added to the blocklist.
+   */
+  added: number
+  /**
+   * How many addresses were removed from the blocklist. Always zero unless
+   * replace was set.
+   */
+  removed: number
+}
+
 export interface IncomingTransactionEvent {
   amount_bitcoin: number
 }
@@ -681,6 +1583,10 @@ export interface Invoice {
    */
   id: string
   lightning_request?: string
+  /**
+   * The metadata given when this invoice was created, if any.
+   */
+  metadata?: { [key: string]: any }
   /**
    * How many blockchain confirmations an on-chain transaction needs before it
    * counts toward the payment status of this invoice.
@@ -691,6 +1597,16 @@ export interface Invoice {
    */
   paid_before_expiry: boolean
   payment_status: InvoiceStatus
+  /**
+   * The invoice that was automatically created to replace this invoice when it
+   * expired, if any.
+   */
+  replaced_by_invoice_id?: string
+  /**
+   * The expired invoice this invoice was automatically created to replace, if
+   * any.
+   */
+  replaces_invoice_id?: string
   /**
    * The amount of money requested in this invoice. It is measured in whole lots
    * of the currency field. If the currency is set to BTC, this field is going
@@ -715,6 +1631,10 @@ export interface Invoice {
    * When this invoice was settled, if at all.
    */
   settle_time?: string
+  /**
+   * The point-of-sale terminal that created this invoice, if any.
+   */
+  terminal_id?: string
   /**
    * IDs of transactions paying to this invoice. This includes any transactions
    * that are yet-to-be accepted, based on the on-chain confirmation threshold
@@ -734,6 +1654,41 @@ export interface InvoiceList {
   total: number
 }
 
+/**
+ * Cryptographic proof that an invoice was paid.
+ */
+export interface InvoiceProof {
+  /**
+   * The Teslacoil ID of the invoice this proof is for.
+   */
+  invoice_id: string
+  /**
+   * The public key of the Teslacoil node that signed the statement.
+   */
+  node_pubkey: string
+  /**
+   * The payment hash of the Lightning request associated with the invoice.
+   */
+  payment_hash: string
+  /**
+   * The preimage of the payment hash. Only known to the payer and Teslacoil
+   * once the invoice is settled, so presenting it proves payment.
+   */
+  preimage: string
+  /**
+   * When the invoice was settled.
+   */
+  settle_time: string
+  /**
+   * The signature made by our node over the statement.
+   */
+  signature: string
+  /**
+   * A statement describing the settled invoice, signed by our node.
+   */
+  statement: string
+}
+
 /**
  * - UNPAID: The invoice has not received a payment
  *  - PAID: This invoice has received a payment for the exact amount we expected
@@ -771,36 +1726,179 @@ export interface ListApiKeysResponse {
   keys: ApiKey[]
 }
 
-export interface ListTradesResponse {
+export interface ListAuditChangesResponse {
+  changes: AuditChange[]
   total: number
-  trades: Trade[]
 }
 
-export type LogLevel = 'TRACE' | 'DEBUG' | 'INFO' | 'WARN' | 'ERROR' | 'OFF'
-
-export interface LogLevels {
-  levels?: {
-    [key: string]: LogLevel
-  }
+export interface ListAuditLogResponse {
+  entries: AuditLogEntry[]
+  total: number
 }
 
-/**
- * - ONCHAIN: A transaction made on the Bitcoin blockchain
- *  - LIGHTNING: A transaction sent on the Lightning Network
- */
-export type NetworkType = 'ONCHAIN' | 'LIGHTNING'
+export interface ListBlocklistResponse {
+  entries: BlocklistEntry[]
+  total: number
+}
 
-export interface NodeAuditResponse {
+export interface ListCallbackAttemptsResponse {
   /**
-   * On chain reports for the period queried.
+   * All attempts at delivering callbacks for the transaction, ordered by when
+   * they were made.
    */
-  reports?: ReportEntry[]
+  attempts: CallbackAttempt[]
 }
 
-/**
- * The valid sides for a trade. Used in getting a RFQ (request for quote), and
- * creating and describing trades.
- *
+export interface ListChannelsResponse {
+  channels: Channel[]
+}
+
+export interface ListDepositAddressesResponse {
+  addresses: StaticDepositAddress[]
+}
+
+export interface ListDisputesResponse {
+  disputes: Dispute[]
+  total: number
+}
+
+export interface ListPaymentRequestsResponse {
+  payment_requests: PaymentRequest[]
+  total: number
+}
+
+export interface ListPayoutsResponse {
+  payouts: Payout[]
+  total: number
+}
+
+export interface ListPendingChannelsResponse {
+  channels: PendingChannel[]
+}
+
+export interface ListQueuedEmailsResponse {
+  emails: QueuedEmail[]
+  total: number
+}
+
+export interface ListSessionsResponse {
+  sessions: Session[]
+}
+
+export interface ListTenantsResponse {
+  tenants: Tenant[]
+}
+
+export interface ListTerminalsResponse {
+  terminals: Terminal[]
+}
+
+export interface ListTradesResponse {
+  total: number
+  trades: Trade[]
+}
+
+export interface ListTransactionEditsResponse {
+  edits: TransactionEdit[]
+}
+
+export interface ListUnsignedWithdrawalsResponse {
+  withdrawals: UnsignedWithdrawal[]
+}
+
+export interface ListUtxosResponse {
+  utxos: Utxo[]
+  /**
+   * The sum of all unspent outputs, measured in satoshis.
+   */
+  total_satoshi: string
+}
+
+export interface ListWebAuthnCredentialsResponse {
+  credentials: WebAuthnCredential[]
+}
+
+export interface ListWebhookEndpointsResponse {
+  endpoints: WebhookEndpoint[]
+}
+
+export type LogLevel = 'TRACE' | 'DEBUG' | 'INFO' | 'WARN' | 'ERROR' | 'OFF'
+
+export interface LogLevels {
+  levels?: {
+    [key: string]: LogLevel
+  }
+}
+
+export interface LogoutRequest {
+  /**
+   * The refresh token to revoke. The JWTs issued from it are revoked as well.
+   * This is a required field.
+   */
+  refresh_token: string
+}
+
+/**
+ * - ONCHAIN: A transaction made on the Bitcoin blockchain
+ *  - LIGHTNING: A transaction sent on the Lightning Network
+ */
+export type NetworkType = 'ONCHAIN' | 'LIGHTNING'
+
+export interface NodeAuditResponse {
+  /**
+   * On chain reports for the period queried.
+   */
+  reports?: ReportEntry[]
+}
+
+export interface OpenChannelRequest {
+  /**
+   * The size of the channel, measured in satoshis. This is a required field.
+   */
+  capacity_satoshi: string
+  /**
+   * If set, we use this as the fee rate for the funding transaction, measured
+   * in satoshi per (virtual) byte.
+   */
+  fee_satoshi_per_byte?: number
+  /**
+   * The address of the peer, e.g. "203.0.113.7:9735". Required if we are not
+   * already connected to the peer.
+   */
+  host?: string
+  /**
+   * Whether or not the channel should be kept out of the public graph.
+   */
+  private?: boolean
+  /**
+   * The public key of the node to open a channel to. This is a required field.
+   */
+  pubkey: string
+  /**
+   * How much of the capacity to give to the peer when the channel is opened,
+   * measured in satoshis.
+   */
+  push_satoshi?: string
+}
+
+/**
+ * All invoices created with a given client ID, along with the combined payment
+ * status of the order.
+ */
+export interface OrderInvoicesResponse {
+  client_id: string
+  invoices: Invoice[]
+  /**
+   * PAID if any of the invoices is paid or overpaid, otherwise the status of
+   * the most recently created invoice.
+   */
+  payment_status: InvoiceStatus
+}
+
+/**
+ * The valid sides for a trade. Used in getting a RFQ (request for quote), and
+ * creating and describing trades.
+ *
  *  - BUY: Buy bitcoin
  *  - SELL: Sell bitcoin
  */
@@ -820,6 +1918,159 @@ export interface Output {
   transaction_output?: number
 }
 
+export interface PairTerminalRequest {
+  /**
+   * An optional description of the terminal, e.g. "Register 2".
+   */
+  description?: string
+  /**
+   * The store this terminal belongs to. Used to group terminals in reports.
+   */
+  store_id?: string
+  /**
+   * Your identifier for this terminal. This is a required field, and must be
+   * unique within the store.
+   */
+  terminal_id: string
+}
+
+export interface PairTerminalResponse {
+  /**
+   * The terminal-scoped API key. It can only be used to create invoices, and
+   * all invoices created with it are stamped with the terminal ID. The key is
+   * only shown once.
+   */
+  key: string
+  terminal: Terminal
+}
+
+/**
+ * A request for payment sent to a customer by email, containing a link to the
+ * hosted checkout page.
+ */
+export interface PaymentRequest {
+  amount: number
+  cancel_time?: string
+  /**
+   * URL to the checkout page linked to in the email.
+   */
+  checkout_url: string
+  create_time: string
+  currency: CurrencyCurrency
+  customer_email: string
+  description: string
+  expire_time: string
+  /**
+   * The Teslacoil ID of this payment request.
+   */
+  id: string
+  /**
+   * The invoice the customer pays to complete this payment request.
+   */
+  invoice_id: string
+  /**
+   * The last time the customer opened the email, if ever.
+   */
+  last_open_time?: string
+  /**
+   * The last time the email was sent to the customer.
+   */
+  last_send_time: string
+  /**
+   * How many times the customer has opened the email.
+   */
+  open_count: number
+  status: PaymentRequestStatus
+}
+
+/**
+ * - SENT: The email is sent, but has not been opened
+ *  - OPENED: The customer has opened the email
+ *  - PAID: The customer has paid the requested amount
+ *  - CANCELLED: The payment request was cancelled, and can no longer be paid
+ *  - EXPIRED: The payment request expired before it was paid
+ */
+export type PaymentRequestStatus = 'SENT' | 'OPENED' | 'PAID' | 'CANCELLED' | 'EXPIRED'
+
+/**
+ * A transfer of accumulated balance to the payout destination of an account,
+ * made according to its payout schedule.
+ */
+export interface Payout {
+  amount_bitcoin: number
+  amount_satoshi: string
+  /**
+   * The IDs of the settled transactions whose funds are covered by this
+   * payout.
+   */
+  covered_transaction_ids: string[]
+  create_time: string
+  /**
+   * The bitcoin address, Lightning address or BOLT12 offer the payout was
+   * sent to.
+   */
+  destination: string
+  /**
+   * The Teslacoil ID of this payout.
+   */
+  id: string
+  status: TxStatus
+  /**
+   * The ID of the withdrawal transaction made for this payout.
+   */
+  transaction_id: string
+}
+
+/**
+ * - DAILY: Pay out the available balance once per day
+ *  - WEEKLY: Pay out the available balance once per week
+ *  - THRESHOLD: Pay out the available balance whenever it exceeds the threshold
+ */
+export type PayoutFrequency = 'DAILY' | 'WEEKLY' | 'THRESHOLD'
+
+export interface PayoutSchedule {
+  /**
+   * Where payouts are sent. Either a bitcoin address, a Lightning address or a
+   * BOLT12 offer.
+   */
+  destination: string
+  /**
+   * Whether or not the destination has been verified. Payouts are not made to
+   * unverified destinations. When the destination is set, a verification code
+   * is emailed to the account owner. Submit it to verify the destination.
+   */
+  destination_verified: boolean
+  enabled: boolean
+  frequency: PayoutFrequency
+  /**
+   * When the next payout is scheduled, if the frequency is time based.
+   */
+  next_payout_time?: string
+  /**
+   * The balance that triggers a payout, if the frequency is THRESHOLD.
+   */
+  threshold_satoshi?: string
+}
+
+/**
+ * A Lightning channel that is being opened or closed.
+ */
+export interface PendingChannel {
+  capacity_satoshi: string
+  channel_point: string
+  local_balance_satoshi: string
+  remote_pubkey: string
+  status: PendingChannelStatus
+}
+
+/**
+ * - OPENING: The funding transaction is waiting for confirmations
+ *  - CLOSING: The channel is being cooperatively closed
+ *  - FORCE_CLOSING: The channel is being unilaterally closed, and our funds are
+ * time locked
+ */
+export type PendingChannelStatus = 'OPENING' | 'CLOSING' | 'FORCE_CLOSING'
+
 export interface Permissions {
   accounting: Privileges
   accounts: Privileges
@@ -922,10 +2173,44 @@ export interface Privileges {
 
 export type Provider = 'ENIGMA' | 'KRAKEN'
 
+/**
+ * An outgoing email in the email queue.
+ */
+export interface QueuedEmail {
+  /**
+   * How many times we have tried to send this email.
+   */
+  attempts: number
+  create_time: string
+  id: string
+  /**
+   * The error from the last failed attempt, if any.
+   */
+  last_error?: string
+  /**
+   * When the next attempt is made, if the email is queued.
+   */
+  next_attempt_time?: string
+  recipient: string
+  send_time?: string
+  status: EmailStatus
+  /**
+   * The template the email was rendered from, e.g. "password_reset".
+   */
+  template: string
+}
+
 export interface RecentEventsResponse {
   events?: Event[]
 }
 
+export interface RefreshTokenRequest {
+  /**
+   * The refresh token returned when getting a JWT. This is a required field.
+   */
+  refresh_token: string
+}
+
 export interface ReportEntry {
   /**
    * The amount of the entry, expressed in millisatoshis.
@@ -972,6 +2257,13 @@ export interface ReportEntry {
   type?: EntryType
 }
 
+export interface ResendPaymentRequestRequest {
+  /**
+   * The Teslacoil ID of the payment request to send again.
+   */
+  id: string
+}
+
 export interface ResetPasswordRequest {
   /**
    * The users password. This is a required field.
@@ -984,6 +2276,26 @@ export interface ResetPasswordRequest {
   token?: string
 }
 
+export interface ResolveDisputeRequest {
+  /**
+   * If resolving as CREDITED, the amount to credit the account, measured in
+   * satoshis. Defaults to the amount of the transaction.
+   */
+  credit_satoshi?: string
+  /**
+   * The Teslacoil ID of the disputed transaction. This is a required field.
+   */
+  id: string
+  /**
+   * A note explaining the resolution. This is visible to the account.
+   */
+  note?: string
+  /**
+   * The new status of the dispute. Cannot be OPEN.
+   */
+  status: DisputeStatus
+}
+
 export interface RestError {
   error?: RestErrorContent
 }
@@ -992,13 +2304,35 @@ export interface RestErrorContent {
   details?: { [key: string]: any }[]
   docs?: string
   message?: string
+  /**
+   * The ID of the request that failed. This is the X-Request-Id header of the
+   * request if it was set, otherwise it is generated by us. Include it when
+   * contacting support.
+   */
+  request_id?: string
   status?: string
 }
 
+export interface RetryCallbackRequest {
+  /**
+   * The Teslacoil ID of the transaction to send the settlement callback for
+   * again. This is a required field.
+   */
+  id: string
+}
+
 export interface RiskLimitsResponse {
   [key: string]: any
 }
 
+export interface RotateWebhookSecretRequest {
+  /**
+   * The ID of the endpoint to rotate the signing secret of. This is a required
+   * field.
+   */
+  id: string
+}
+
 export interface SendPasswordResetEmailRequest {
   /**
    * The email the user signed up with. This is a required field.
@@ -1014,6 +2348,35 @@ export interface SentOnchainTransactionEvent {
   amount_bitcoin: number
 }
 
+/**
+ * A signed in device, created when getting a JWT with a password.
+ */
+export interface Session {
+  create_time: string
+  /**
+   * Whether or not this is the session making the request.
+   */
+  current: boolean
+  id: string
+  ip_address: string
+  last_seen_time: string
+  user_agent: string
+}
+
+export interface SetAccountFeePolicyRequest {
+  /**
+   * The Teslacoil ID of the account to set the fee policy of. This is a
+   * required field.
+   */
+  account_id: string
+  new_fee_policy?: FeePolicy
+  /**
+   * If this field is set, the fee policy of the account is removed, and the
+   * fee policy of its tenant is used going forward.
+   */
+  remove_fee_policy?: boolean
+}
+
 export interface SetLogLevelsRequest {
   level?: LogLevel
   levels?: SetLogLevelsRequestDetailed
@@ -1025,6 +2388,32 @@ export interface SetLogLevelsRequestDetailed {
   }
 }
 
+export interface SetSupportAgentRequest {
+  /**
+   * Whether or not the user should be a support agent. This is a required
+   * field.
+   */
+  support: boolean
+  /**
+   * The ID of the user to grant or revoke the support role for. This is a
+   * required field.
+   */
+  user_id: string
+}
+
+export interface SetTransactionLabelsRequest {
+  /**
+   * The Teslacoil ID of the transaction to label. This is a required field.
+   */
+  id: string
+  /**
+   * The labels the transaction should have. Replaces any existing labels, so
+   * an empty list removes all labels. Labels can not contain commas, as the
+   * label filter of the transaction list is comma separated.
+   */
+  labels: string[]
+}
+
 /**
  * - DESCENDING: Sort transactions descending, chronologically
  *  - ASCENDING: Sort transactions ascending, chronologically
@@ -1039,10 +2428,98 @@ export interface Statement {
   network_fee_milli_sat?: string
   opening_balance_milli_sat?: string
   outbound_milli_sat?: string
+  /**
+   * The total processing fees deducted in the period.
+   */
+  platform_fee_milli_sat?: string
   start_time?: string
   transactions?: AccountingTransaction[]
 }
 
+/**
+ * A deposit address that can be reused for deposits to an account. The
+ * current address is rotated after it receives funds, but all previous
+ * addresses are still watched and credited.
+ */
+export interface StaticDepositAddress {
+  address: string
+  address_type: AddressType
+  create_time: string
+  /**
+   * Whether or not this is the address new deposits should be sent to.
+   */
+  current: boolean
+  /**
+   * When this address was rotated out, if at all.
+   */
+  rotate_time?: string
+  /**
+   * How many deposits this address has received.
+   */
+  transaction_count: number
+}
+
+/**
+ * - HOUR: One data point per hour
+ *  - DAY: One data point per day
+ *  - WEEK: One data point per week
+ */
+export type StatsInterval = 'HOUR' | 'DAY' | 'WEEK'
+
+export interface SubmitSignedWithdrawalRequest {
+  /**
+   * The Teslacoil ID of the withdrawal the PSBT was created for. This is a
+   * required field.
+   */
+  id: string
+  /**
+   * The base64 encoded, fully signed PSBT. This is a required field.
+   */
+  signed_psbt: string
+}
+
+/**
+ * A white-labeled payment processor served from this deployment. Users,
+ * transactions and callbacks all belong to exactly one tenant.
+ */
+export interface Tenant {
+  create_time: string
+  custom_domain: string
+  email_from_address: string
+  fee_policy: FeePolicy
+  /**
+   * The Teslacoil ID of this tenant.
+   */
+  id: string
+  logo_url: string
+  name: string
+}
+
+/**
+ * A point-of-sale terminal paired with an account.
+ */
+export interface Terminal {
+  account_id: string
+  create_time: string
+  description: string
+  /**
+   * The Teslacoil ID of this terminal.
+   */
+  id: string
+  /**
+   * The last letters of the API key issued to this terminal.
+   */
+  last_letters: string
+  last_use_time?: string
+  /**
+   * When this terminal was revoked, if at all. Revoked terminals can no longer
+   * create invoices.
+   */
+  revoke_time?: string
+  store_id: string
+  terminal_id: string
+}
+
 export interface TeslaPayDeposit {
   account_profile_picture: string
   /**
@@ -1152,6 +2629,18 @@ export interface TeslaPayWithdrawal {
   redirect_url: string
 }
 
+export interface TestWebhookRequest {
+  /**
+   * Which kind of callback to send a sample payload for. Defaults to
+   * lightning-invoice.
+   */
+  identifier?: CallbackIdentifier
+  /**
+   * The URL to send the sample callback to. This is a required field.
+   */
+  url: string
+}
+
 export interface Trade {
   amount_base: number
   amount_quote: number
@@ -1188,6 +2677,110 @@ export type TradeStatus = 'TRADE_FAILED' | 'TRADE_ACCEPTED' | 'TRADE_INITIATED'
  */
 export type TransactionDirection = 'INCOMING' | 'OUTGOING'
 
+/**
+ * A change made to the description or notes of a transaction.
+ */
+export interface TransactionEdit {
+  edit_time: string
+  /**
+   * Which field was changed.
+   */
+  field: 'description' | 'notes'
+  new_value: string
+  old_value: string
+  /**
+   * The user that made the change.
+   */
+  user_id: string
+}
+
+/**
+ * - INVOICE_SETTLED: An invoice received sufficient payment
+ *  - DEPOSIT_CONFIRMED: An incoming on-chain transaction reached the confirmation
+ * threshold
+ *  - WITHDRAWAL_BROADCAST: An outgoing on-chain transaction was broadcast
+ */
+export type TransactionUpdateEvent = 'INVOICE_SETTLED' | 'DEPOSIT_CONFIRMED' | 'WITHDRAWAL_BROADCAST'
+
+/**
+ * A message pushed over the /v0/ws WebSocket endpoint. Exactly one of invoice
+ * and transaction is set, depending on the event.
+ */
+export interface TransactionUpdateMessage {
+  event: TransactionUpdateEvent
+  invoice?: Invoice
+  time: string
+  transaction?: TxTransaction
+}
+
+/**
+ * A payment that can be settled by either an on-chain transaction or a
+ * Lightning payment. Whichever rail is paid first settles the payment, and the
+ * invoice for the other rail is cancelled.
+ */
+export interface UnifiedPayment {
+  /**
+   * The BIP21 URI for this payment. It contains the bitcoin address, the
+   * amount and a lightning parameter with the Lightning request, so wallets
+   * supporting either rail can pay it.
+   */
+  bip21_uri: string
+  /**
+   * The bitcoin address customers can pay this payment to.
+   */
+  bitcoin_address: string
+  /**
+   * URL to Teslacoil checkout page. Users can be sent here, to pay the amount
+   * requested in the payment.
+   */
+  checkout_url: string
+  client_id: string
+  create_time: string
+  /**
+   * The Teslacoil ID for this payment.
+   */
+  id: string
+  /**
+   * The Lightning invoice created for this payment.
+   */
+  lightning_invoice_id: string
+  /**
+   * The Lightning request customers can pay this payment to.
+   */
+  lightning_request: string
+  /**
+   * The metadata given when this payment was created, if any.
+   */
+  metadata?: { [key: string]: any }
+  /**
+   * The on-chain invoice created for this payment.
+   */
+  onchain_invoice_id: string
+  /**
+   * Which rail settled this payment, if any.
+   */
+  settled_network_type?: NetworkType
+  status: InvoiceStatus
+}
+
+/**
+ * A large withdrawal that is waiting to be signed by cold storage before it is
+ * broadcast.
+ */
+export interface UnsignedWithdrawal {
+  address: string
+  amount_satoshi: string
+  create_time: string
+  /**
+   * The Teslacoil ID of the withdrawal.
+   */
+  id: string
+  /**
+   * The base64 encoded, unsigned and funded PSBT for this withdrawal.
+   */
+  psbt: string
+}
+
 export interface UpdateAccessRequest {
   new_permissions?: Permissions
   user_id?: string
@@ -1208,6 +2801,11 @@ export interface UpdateAccountRequest {
    * for fee estimation. If set, smallest value is 1, highest is 1008.
    */
   default_conf_target?: number
+  /**
+   * If set, enables or disables emailing the monthly account statement to the
+   * account owner.
+   */
+  email_monthly_statements?: boolean
   /**
    * New logo for the account. Expects base64-encoded string.
    */
@@ -1226,9 +2824,65 @@ export interface UpdateAccountRequest {
   remove_auto_exchange_currency?: boolean
 }
 
+export interface UpdateChannelFeeRulesRequest {
+  new_default_policy?: ChannelFeePolicy
+  /**
+   * If set, replaces all existing rules.
+   */
+  new_rules?: ChannelFeeRule[]
+  new_update_interval_seconds?: number
+}
+
+export interface UpdatePayoutScheduleRequest {
+  /**
+   * A new destination for payouts. Changing the destination requires it to be
+   * verified again with the code emailed to the account owner before any
+   * payouts are made.
+   */
+  new_destination?: string
+  new_enabled?: boolean
+  new_frequency?: PayoutFrequency
+  new_threshold_satoshi?: string
+}
+
+export interface UpdateTenantRequest {
+  id?: string
+  new_custom_domain?: string
+  new_email_from_address?: string
+  new_fee_policy?: FeePolicy
+  /**
+   * New logo for the tenant. Expects base64-encoded string.
+   */
+  new_logo?: string
+  new_name?: string
+}
+
+/**
+ * The fields of a transaction that can be changed after it is created.
+ * Amounts and statuses can never be changed.
+ */
+export interface UpdateTransactionRequest {
+  /**
+   * The Teslacoil ID of the transaction to update. This is a required field.
+   */
+  id: string
+  new_description?: string
+  new_notes?: string
+}
+
 export interface UpdateUserRequest {
   first_name?: string
   last_name?: string
+  /**
+   * The username part of the Lightning address for this user, i.e. "alice" in
+   * alice@api.teslacoil.io. Must be unique.
+   */
+  lightning_address_username?: string
+  /**
+   * The language emails to this user are written in, as an IETF language
+   * tag, e.g. "en" or "nb-NO".
+   */
+  locale?: string
   preferred_display_currency?: CryptoCurrencyFormat
 }
 
@@ -1256,9 +2910,126 @@ export interface User {
   first_name: string
   id: string
   last_name: string
+  /**
+   * The Lightning address this user can receive payments to, e.g.
+   * alice@api.teslacoil.io. Payments are credited without having to create
+   * an invoice first. Not set if the user has not chosen a username.
+   */
+  lightning_address?: string
+  /**
+   * The language emails to this user are written in. Defaults to "en".
+   */
+  locale: string
   preferred_crypto_display_currency: CryptoCurrencyFormat
 }
 
+/**
+ * An unspent output in the node wallet.
+ */
+export interface Utxo {
+  address: string
+  address_type: AddressType
+  amount_satoshi: string
+  confirmations: number
+  /**
+   * The bitcoin transaction ID of the transaction that created this output.
+   */
+  network_id: string
+  transaction_output: number
+}
+
+export interface VerifyPayoutDestinationRequest {
+  /**
+   * The verification code emailed to the account owner when the destination
+   * was set. This is a required field.
+   */
+  code: string
+}
+
+export interface VerifyPreimageRequest {
+  /**
+   * The payment hash to check the preimage against. This is a required field.
+   */
+  payment_hash: string
+  /**
+   * The hex encoded preimage presented as proof of payment. This is a required
+   * field.
+   */
+  preimage: string
+}
+
+export interface VerifyPreimageResponse {
+  /**
+   * Whether or not the preimage hashes to the given payment hash.
+   */
+  valid: boolean
+}
+
+/**
+ * Contains the options to pass to navigator.credentials.create() when
+ * registering, or navigator.credentials.get() when signing in.
+ */
+export interface WebAuthnChallengeResponse {
+  options: { [key: string]: any }
+}
+
+/**
+ * A FIDO2/WebAuthn credential registered as a second factor.
+ */
+export interface WebAuthnCredential {
+  create_time: string
+  id: string
+  last_use_time?: string
+  name: string
+}
+
+/**
+ * A URL callbacks are sent to, with its own signing secret.
+ */
+export interface WebhookEndpoint {
+  create_time: string
+  id: string
+  /**
+   * When the signing secret was last rotated, if ever.
+   */
+  rotate_time?: string
+  /**
+   * The last letters of the signing secret. This is stored so it is easier to
+   * identify which secret is in use.
+   */
+  secret_last_letters: string
+  url: string
+}
+
+export interface WebhookSecretResponse {
+  endpoint: WebhookEndpoint
+  /**
+   * The signing secret for the endpoint. It is only shown once.
+   */
+  secret: string
+}
+
+/**
+ * A signed, short-lived configuration consumed by the embeddable payment
+ * widget.
+ */
+export interface WidgetConfig {
+  allowed_origins: string[]
+  amount: number
+  client_id: string
+  currency: CurrencyCurrency
+  expire_time: string
+  /**
+   * The Teslacoil ID of this widget configuration.
+   */
+  id: string
+  /**
+   * The signed token to pass to the widget. The widget refuses to load if the
+   * token is expired, or if the page origin is not one of the allowed origins.
+   */
+  token: string
+}
+
 /**
  * ISO 4217: alpha 3-letter e.g EUR, BTC.
  *
@@ -1324,6 +3095,14 @@ export type LnurlTag = 'WITHDRAW_REQUEST'
 
 export interface TxLightning {
   amount_bitcoin: number
+  /**
+   * The value of this transaction in the fiat currency when it was created.
+   */
+  amount_fiat?: number
+  /**
+   * The value of this transaction in the fiat currency when it was settled.
+   */
+  amount_fiat_settlement?: number
   amount_satoshi: string
   /**
    * The URL to hit when the status of this transaction changes.
@@ -1346,17 +3125,26 @@ export interface TxLightning {
    */
   description: string
   direction: TransactionDirection
+  /**
+   * The currency the fiat amounts of this transaction are denominated in.
+   */
+  fiat_currency?: FiatcurrencyFiatCurrency
   /**
    * The hashed preimage of this transaction.
    */
   hashed_preimage: string
   id: string
   invoice_id?: string
+  /**
+   * Whether or not this is a spontaneous (keysend) payment. Keysend payments
+   * are sent to our node without an invoice, and have no lightning request.
+   */
+  keysend: boolean
   /**
    * The lightning request belonging to this transactions. All Lightning
-   * transactions have a lightning request associated with them. Lightning
-   * requests specify the recipient and amount of a transaction, as well as
-   * other, optional, information.
+   * transactions except keysend payments have a lightning request associated
+   * with them. Lightning requests specify the recipient and amount of a
+   * transaction, as well as other, optional, information.
    */
   lightning_request: string
   /**
@@ -1385,6 +3173,12 @@ export interface TxLightning {
 }
 
 export interface TxListResponse {
+  /**
+   * A cursor pointing to the next page of transactions. Not set if this is the
+   * last page. The cursor is an opaque token that may contain characters like
+   * +, / and =, so pass it back exactly as received.
+   */
+  next_cursor?: string
   /**
    * How many transactions matched the filtering options sent. Can be used to
    * implement pagination client-side.
@@ -1410,6 +3204,14 @@ export interface TxOnchain {
    * account. Measured in bitcoin.
    */
   amount_bitcoin: number
+  /**
+   * The value of this transaction in the fiat currency when it was created.
+   */
+  amount_fiat?: number
+  /**
+   * The value of this transaction in the fiat currency when it was settled.
+   */
+  amount_fiat_settlement?: number
   /**
    * How much this transaction was for, i.e. how much it credited/debited the
    * account. Measured in satoshis.
@@ -1427,6 +3229,11 @@ export interface TxOnchain {
    * API.
    */
   client_id: string
+  /**
+   * If this transaction is confirmed, this is the hash of the block that the
+   * transaction was confirmed in.
+   */
+  confirmation_block_hash: string
   /**
    * If this transaction is confirmed, this is the block height that the
    * transaction was confirmed at.
@@ -1440,7 +3247,9 @@ export interface TxOnchain {
   /**
    * Whether or not this transaction has been confirmed by being placed into the
    * Bitcoin blockchain. This does not happen immediately after a transaction is
-   * made, because a Bitcoin miner needs to process it first.
+   * made, because a Bitcoin miner needs to process it first. If the confirming
+   * block is orphaned by a reorg, the transaction becomes unconfirmed again,
+   * and a callback is sent.
    */
   confirmed: boolean
   /**
@@ -1449,6 +3258,10 @@ export interface TxOnchain {
   create_time: string
   description: string
   direction: TransactionDirection
+  /**
+   * The currency the fiat amounts of this transaction are denominated in.
+   */
+  fiat_currency?: FiatcurrencyFiatCurrency
   /**
    * The internal Teslacoil ID of this transaction. Can be used to retrieve the
    * transaction at a later point in time.
@@ -1479,6 +3292,12 @@ export interface TxOnchain {
 }
 
 export interface TxSendLightningRequest {
+  /**
+   * Whether or not the payment can be split into several parts sent over
+   * different routes. Defaults to true. Splitting makes large payments much
+   * more likely to succeed.
+   */
+  allow_multi_path?: boolean
   /**
    * The URL we send a POST request to when the transaction is completed.
    */
@@ -1499,6 +3318,21 @@ export interface TxSendLightningRequest {
   description?: string
   exchange_currency?: FiatcurrencyFiatCurrency
   lightning_request?: string
+  /**
+   * The maximum routing fee you are willing to pay, measured as a fraction of
+   * the amount sent, e.g. 0.01 for 1%. If both this and max fee satoshi is
+   * set, the lowest of them is used.
+   */
+  max_fee_fraction?: number
+  /**
+   * The maximum routing fee you are willing to pay, measured in satoshis.
+   */
+  max_fee_satoshi?: string
+  /**
+   * How long we try to find a route before giving up, measured in seconds.
+   * Defaults to 60.
+   */
+  timeout_seconds?: number
 }
 
 export interface TxSendOnchainRequest {
@@ -1525,6 +3359,11 @@ export interface TxSendOnchainRequest {
    * make sure yourself that it only identifies a single element.
    */
   client_id?: string
+  /**
+   * How to select the coins spent by this transaction. Defaults to DEFAULT.
+   * Only available to admins.
+   */
+  coin_selection?: CoinSelectionStrategy
   currency?: CurrencyCurrency
   /**
    * An (optional) description to associate with this transaction. Only visible
@@ -1532,11 +3371,23 @@ export interface TxSendOnchainRequest {
    */
   description?: string
   exchange_currency?: FiatcurrencyFiatCurrency
+  /**
+   * If set, the transaction is not sent until this time. Until then it is
+   * SCHEDULED, and can be cancelled. Balance and limits are checked again when
+   * the transaction is sent.
+   */
+  execute_time?: string
   /**
    * If set, we use this as the fee rate for your transaction, measured in
    * satoshi per (virtual) byte.
    */
   fee_satoshi_per_byte?: number
+  /**
+   * If set, the transaction is SCHEDULED until the estimated fee rate drops
+   * to or below this value, measured in satoshi per (virtual) byte. Can be
+   * combined with execute time, in which case both conditions must be met.
+   */
+  max_fee_satoshi_per_byte?: number
   /**
    * If set, we try and construct the transaction such that it is confirmed by
    * this number of blocks. A higher value here means a lower network fee, but
@@ -1545,6 +3396,11 @@ export interface TxSendOnchainRequest {
    * it would be more expensive.
    */
   target_confirmation?: number
+  /**
+   * The outputs to spend, formatted as "<network ID>:<output index>". Must be
+   * set if coin selection is MANUAL, and cannot be set otherwise.
+   */
+  utxos?: string[]
 }
 
 /**
@@ -1586,12 +3442,25 @@ export interface TxSettlement {
  * failed yet
  *  - COMPLETED: The transaction has been received by the recipient, and is settled.
  *  - FAILED: The transaction has failed
+ *  - PENDING_APPROVAL: The withdrawal is above the approval threshold, and is waiting
+ * for a second admin to approve it before it is sent
+ *  - SCHEDULED: The withdrawal is waiting for its execute time or fee rate condition
+ *  - CANCELLED: The withdrawal was cancelled before it was broadcast, and the amount
+ * was returned to the account balance
  */
-export type TxStatus = 'PENDING' | 'COMPLETED' | 'FAILED'
+export type TxStatus = 'PENDING' | 'COMPLETED' | 'FAILED' | 'PENDING_APPROVAL' | 'SCHEDULED' | 'CANCELLED'
 
 export interface TxTransaction {
   account_id: string
   amount_bitcoin: number
+  /**
+   * The value of this transaction in the fiat currency when it was created.
+   */
+  amount_fiat?: number
+  /**
+   * The value of this transaction in the fiat currency when it was settled.
+   */
+  amount_fiat_settlement?: number
   amount_satoshi: string
   /**
    * The URL, if any, to send updates to whenever events related to this
@@ -1607,8 +3476,20 @@ export interface TxTransaction {
    */
   destination: string
   direction: TransactionDirection
-  id: string
+  /**
+   * The dispute raised on this transaction, if any.
+   */
+  dispute?: Dispute
+  /**
+   * The currency the fiat amounts of this transaction are denominated in.
+   */
+  fiat_currency?: FiatcurrencyFiatCurrency
+  id: string
   invoice_id?: string
+  /**
+   * Labels used to categorize this transaction, e.g. "refunded" or "pos-1".
+   */
+  labels: string[]
   network_fee_bitcoin: number
   network_fee_satoshi: string
   /**
@@ -1618,7 +3499,32 @@ export interface TxTransaction {
    */
   network_id: string
   network_type: NetworkType
+  /**
+   * Internal notes associated with this transaction. This is only visible to
+   * the owner of the transaction.
+   */
+  notes?: string
+  /**
+   * The processing fee deducted from this transaction when it was settled,
+   * measured in satoshis.
+   */
+  platform_fee_satoshi?: string
+  /**
+   * If this transaction is held for manual review by our risk checks, this
+   * field explains why. Held deposits are not credited, and held withdrawals
+   * are not broadcast, until the review is complete.
+   */
+  review_hold_reason?: string
+  /**
+   * When a scheduled withdrawal is set to be sent, if it has an execute time.
+   */
+  scheduled_time?: string
   status: TxStatus
+  /**
+   * The point-of-sale terminal that created the invoice this transaction
+   * paid, if any.
+   */
+  terminal_id?: string
   trades: Trade[]
 }
 
@@ -1648,7 +3554,7 @@ export const Accounting_NodeAudit = async (start_time?: string, end_time?: strin
     )
     return response.data as NodeAuditResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1670,7 +3576,58 @@ export const Accounting_GetStatement = async (start_time?: string, end_time?: st
     )
     return response.data as Statement
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AccountingGetMonthlyStatementQueryParams {
+  /**
+   * The year of the statement, e.g. 2021.
+   */
+  year?: number
+  /**
+   * The month of the statement, from 1 (January) to 12 (December).
+   */
+  month?: number
+}
+
+export const Accounting_GetMonthlyStatement = async (year?: number, month?: number): Promise<Statement> => {
+  try {
+    const response = await api.get(buildURL('/v0/accounting/statement/monthly', ['year', year], ['month', month]))
+    return response.data as Statement
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AccountingExportQueryParams {
+  /**
+   * The format of the export. The export includes the fiat value of each
+   * transaction at settlement time.
+   *
+   *  - QUICKBOOKS_IIF: Intuit Interchange Format, for importing into QuickBooks
+   *  - XERO_CSV: CSV formatted for importing bank statements into Xero
+   *  - DOUBLE_ENTRY_CSV: Generic CSV with a debit and a credit line per transaction
+   */
+  format?: 'QUICKBOOKS_IIF' | 'XERO_CSV' | 'DOUBLE_ENTRY_CSV'
+  /**
+   * Only export transactions settled after this time.
+   */
+  start_time?: string
+  /**
+   * Only export transactions settled before this time.
+   */
+  end_time?: string
+}
+
+export const Accounting_Export = async (format?: string, start_time?: string, end_time?: string): Promise<string> => {
+  try {
+    const response = await api.get(
+      buildURL('/v0/accounting/export', ['format', format], ['start_time', start_time], ['end_time', end_time])
+    )
+    return response.data as string
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1679,7 +3636,7 @@ export const Accounts_Get = async (): Promise<Account> => {
     const response = await api.get(buildURL('/v0/accounts'))
     return response.data as Account
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1688,7 +3645,7 @@ export const Accounts_Create = async (req: CreateAccountRequest): Promise<Accoun
     const response = await api.post('/v0/accounts', req)
     return response.data as Account
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1697,7 +3654,7 @@ export const Accounts_Update = async (req: UpdateAccountRequest): Promise<Accoun
     const response = await api.put('/v0/accounts', req)
     return response.data as Account
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1715,7 +3672,7 @@ export const Accounts_RemoveAccess = async (user_id?: string): Promise<{}> => {
     const response = await api.delete(buildURL('/v0/accounts/access', ['user_id', user_id]))
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1726,7 +3683,7 @@ export const Accounts_UpdateAccess = async (req: UpdateAccessRequest): Promise<{
     const response = await api.post('/v0/accounts/access', req)
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1737,7 +3694,7 @@ export const Accounts_GiveAccess = async (req: GiveAccessRequest): Promise<{}> =
     const response = await api.put('/v0/accounts/access', req)
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1746,7 +3703,7 @@ export const Accounts_List = async (): Promise<ListAccountsResponse> => {
     const response = await api.get(buildURL('/v0/accounts/list'))
     return response.data as ListAccountsResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1755,7 +3712,7 @@ export const Accounts_ListAccountNames = async (): Promise<ListAccountNamesRespo
     const response = await api.get(buildURL('/v0/accounts/names'))
     return response.data as ListAccountNamesResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1766,7 +3723,7 @@ export const Accounts_AddOrUpdateShopifyIntegration = async (
     const response = await api.post('/v0/accounts/shopify', req)
     return response.data as AddOrUpdateShopifyIntegrationResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1783,7 +3740,45 @@ export const Accounts_GetUserInfo = async (user_id?: string): Promise<AccountUse
     const response = await api.get(buildURL('/v0/accounts/user', ['user_id', user_id]))
     return response.data as AccountUser
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Accounts_GetDepositAddress = async (): Promise<StaticDepositAddress> => {
+  try {
+    const response = await api.get(buildURL('/v0/accounts/deposit_address'))
+    return response.data as StaticDepositAddress
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AccountsRotateDepositAddressRequestBody {}
+
+export const Accounts_RotateDepositAddress = async (): Promise<StaticDepositAddress> => {
+  try {
+    const response = await api.post('/v0/accounts/deposit_address')
+    return response.data as StaticDepositAddress
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Accounts_ListDepositAddresses = async (): Promise<ListDepositAddressesResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/accounts/deposit_address/list'))
+    return response.data as ListDepositAddressesResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Accounts_SetFeePolicy = async (req: SetAccountFeePolicyRequest): Promise<Account> => {
+  try {
+    const response = await api.put('/v0/accounts/fee_policy', req)
+    return response.data as Account
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1799,7 +3794,7 @@ export const ApiKeys_Delete = async (hash?: string): Promise<ApiKey> => {
     const response = await api.delete(buildURL('/v0/apikeys', ['hash', hash]))
     return response.data as ApiKey
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1815,7 +3810,7 @@ export const ApiKeys_Get = async (hash?: string): Promise<ApiKey> => {
     const response = await api.get(buildURL('/v0/apikeys', ['hash', hash]))
     return response.data as ApiKey
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1824,7 +3819,7 @@ export const ApiKeys_Create = async (req: CreateApiKeyRequest): Promise<CreateAp
     const response = await api.post('/v0/apikeys', req)
     return response.data as CreateApiKeyResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1833,7 +3828,33 @@ export const ApiKeys_List = async (): Promise<ListApiKeysResponse> => {
     const response = await api.get(buildURL('/v0/apikeys/list'))
     return response.data as ListApiKeysResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface ApiKeysGetUsageQueryParams {
+  /**
+   * The hash of the API key you want usage for.
+   */
+  hash?: string
+  /**
+   * Only include requests made after this time. Defaults to 30 days ago.
+   */
+  start_time?: string
+  /**
+   * Only include requests made before this time.
+   */
+  end_time?: string
+}
+
+export const ApiKeys_GetUsage = async (hash?: string, start_time?: string, end_time?: string): Promise<ApiKeyUsage> => {
+  try {
+    const response = await api.get(
+      buildURL('/v0/apikeys/usage', ['hash', hash], ['start_time', start_time], ['end_time', end_time])
+    )
+    return response.data as ApiKeyUsage
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1844,18 +3865,27 @@ export const Authentication_ChangePassword = async (req: ChangePasswordRequest):
     const response = await api.put('/v0/auth/change_password', req)
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export interface AuthenticationConfirm2faResponse {}
-
-export const Authentication_Confirm2fa = async (req: Confirm2faRequest): Promise<{}> => {
+export const Authentication_Confirm2fa = async (req: Confirm2faRequest): Promise<Confirm2faResponse> => {
   try {
     const response = await api.put('/v0/auth/confirm_2fa', req)
+    return response.data as Confirm2faResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AuthenticationDelete2faResponse {}
+
+export const Authentication_Delete2fa = async (req: Delete2faRequest): Promise<{}> => {
+  try {
+    const response = await api.post('/v0/auth/delete_2fa', req)
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1866,7 +3896,7 @@ export const Authentication_Create2fa = async (): Promise<Create2faResponse> =>
     const response = await api.post('/v0/auth/create_2fa')
     return response.data as Create2faResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1875,7 +3905,7 @@ export const Authentication_GetJwt = async (req: GetJwtRequest): Promise<GetJwtR
     const response = await api.post('/v0/auth/get_jwt', req)
     return response.data as GetJwtResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1884,7 +3914,7 @@ export const Authentication_RefreshJwt = async (): Promise<GetJwtResponse> => {
     const response = await api.get(buildURL('/v0/auth/refresh_jwt'))
     return response.data as GetJwtResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1895,7 +3925,7 @@ export const Authentication_ResetPassword = async (req: ResetPasswordRequest): P
     const response = await api.put('/v0/auth/reset_password', req)
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1906,7 +3936,136 @@ export const Authentication_SendPasswordResetEmail = async (req: SendPasswordRes
     const response = await api.post('/v0/auth/send_password_reset_email', req)
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AuthenticationListAuditLogQueryParams {
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many entries to fetch. Together with specifying an offset, allows for
+   * implementation of pagination.
+   */
+  limit?: number
+}
+
+export const Authentication_ListAuditLog = async (offset?: number, limit?: number): Promise<ListAuditLogResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/auth/audit', ['offset', offset], ['limit', limit]))
+    return response.data as ListAuditLogResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Authentication_Refresh = async (req: RefreshTokenRequest): Promise<GetJwtResponse> => {
+  try {
+    const response = await api.post('/v0/auth/refresh', req)
+    return response.data as GetJwtResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AuthenticationLogoutResponse {}
+
+export const Authentication_Logout = async (req: LogoutRequest): Promise<{}> => {
+  try {
+    const response = await api.post('/v0/auth/logout', req)
+    return response.data as {}
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Authentication_ListSessions = async (): Promise<ListSessionsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/auth/sessions'))
+    return response.data as ListSessionsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AuthenticationRevokeSessionResponse {}
+
+export interface AuthenticationRevokeSessionQueryParams {
+  /**
+   * The ID of the session to sign out. Its refresh token and JWTs are revoked.
+   */
+  id?: string
+}
+
+export const Authentication_RevokeSession = async (id?: string): Promise<{}> => {
+  try {
+    const response = await api.delete(buildURL('/v0/auth/sessions', ['id', id]))
+    return response.data as {}
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AuthenticationBeginWebAuthnRegistrationRequestBody {}
+
+export const Authentication_BeginWebAuthnRegistration = async (): Promise<WebAuthnChallengeResponse> => {
+  try {
+    const response = await api.post('/v0/auth/webauthn/begin_registration')
+    return response.data as WebAuthnChallengeResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Authentication_FinishWebAuthnRegistration = async (
+  req: FinishWebAuthnRegistrationRequest
+): Promise<WebAuthnCredential> => {
+  try {
+    const response = await api.post('/v0/auth/webauthn/finish_registration', req)
+    return response.data as WebAuthnCredential
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Authentication_BeginWebAuthnLogin = async (
+  req: BeginWebAuthnLoginRequest
+): Promise<WebAuthnChallengeResponse> => {
+  try {
+    const response = await api.post('/v0/auth/webauthn/begin_login', req)
+    return response.data as WebAuthnChallengeResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Authentication_ListWebAuthnCredentials = async (): Promise<ListWebAuthnCredentialsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/auth/webauthn'))
+    return response.data as ListWebAuthnCredentialsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface AuthenticationDeleteWebAuthnCredentialResponse {}
+
+export interface AuthenticationDeleteWebAuthnCredentialQueryParams {
+  /**
+   * The ID of the credential to remove.
+   */
+  id?: string
+}
+
+export const Authentication_DeleteWebAuthnCredential = async (id?: string): Promise<{}> => {
+  try {
+    const response = await api.delete(buildURL('/v0/auth/webauthn', ['id', id]))
+    return response.data as {}
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1922,7 +4081,73 @@ export const Blockchain_GetTransaction = async (network_id?: string): Promise<Bl
     const response = await api.get(buildURL('/v0/blockchain/transaction', ['network_id', network_id]))
     return response.data as BlockchainTransaction
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Channels_GetFeeRules = async (): Promise<ChannelFeeRulesResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/channels/fees'))
+    return response.data as ChannelFeeRulesResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Channels_UpdateFeeRules = async (req: UpdateChannelFeeRulesRequest): Promise<ChannelFeeRulesResponse> => {
+  try {
+    const response = await api.put('/v0/channels/fees', req)
+    return response.data as ChannelFeeRulesResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Channels_List = async (): Promise<ListChannelsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/channels/list'))
+    return response.data as ListChannelsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Channels_ListPending = async (): Promise<ListPendingChannelsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/channels/pending'))
+    return response.data as ListPendingChannelsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Channels_Open = async (req: OpenChannelRequest): Promise<PendingChannel> => {
+  try {
+    const response = await api.post('/v0/channels', req)
+    return response.data as PendingChannel
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface ChannelsCloseQueryParams {
+  /**
+   * The funding outpoint of the channel to close.
+   */
+  channel_point?: string
+  /**
+   * Whether to unilaterally close the channel. Only use this if the peer is
+   * unresponsive, as our funds are time locked after a force close.
+   */
+  force?: boolean
+}
+
+export const Channels_Close = async (channel_point?: string, force?: boolean): Promise<PendingChannel> => {
+  try {
+    const response = await api.delete(buildURL('/v0/channels', ['channel_point', channel_point], ['force', force]))
+    return response.data as PendingChannel
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1962,7 +4187,7 @@ export const Currencies_Convert = async (
     )
     return response.data as CurrenciesConvertResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -1996,7 +4221,7 @@ export const Currencies_Quote = async (
     )
     return response.data as CurrenciesQuoteResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2005,7 +4230,7 @@ export const Exchange_RiskLimits = async (): Promise<RiskLimitsResponse> => {
     const response = await api.get(buildURL('/v0/exchange/limits'))
     return response.data as RiskLimitsResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2014,7 +4239,7 @@ export const Exchange_ListSettlements = async (): Promise<TxListSettlementsRespo
     const response = await api.get(buildURL('/v0/exchange/settlements/list'))
     return response.data as TxListSettlementsResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2023,7 +4248,7 @@ export const Exchange_CreateTrade = async (req: CreateTradeRequest): Promise<Tra
     const response = await api.post('/v0/exchange/trades', req)
     return response.data as Trade
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2096,7 +4321,7 @@ export const Exchange_ListTrades = async (
     )
     return response.data as ListTradesResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2121,7 +4346,7 @@ export const Fees_EstimateBlockchainFees = async (
     const response = await api.get(buildURL('/v0/fees/estimate/blockchain', ['target', target], ['currency', currency]))
     return response.data as EstimateBlockchainFeesResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2148,7 +4373,24 @@ export const Fees_EstimateLightningFees = async (
     )
     return response.data as EstimateLightningFeesResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface FeesGetFeesQueryParams {
+  /**
+   * If set, the response includes an estimated Lightning routing fee for
+   * sending this amount, measured in satoshis.
+   */
+  amount_satoshi?: string
+}
+
+export const Fees_GetFees = async (amount_satoshi?: string): Promise<GetFeesResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/fees', ['amount_satoshi', amount_satoshi]))
+    return response.data as GetFeesResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2199,7 +4441,7 @@ export const Invoices_Get = async (
     )
     return response.data as Invoice
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2208,7 +4450,7 @@ export const Invoices_Create = async (req: CreateInvoiceRequest): Promise<Invoic
     const response = await api.post('/v0/invoices', req)
     return response.data as Invoice
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2294,6 +4536,11 @@ export interface InvoicesListQueryParams {
    *  - AMOUNT: Sort invoices by the amount they are for.
    */
   sort_by?: 'CREATE_TIME' | 'STATUS' | 'AMOUNT'
+  /**
+   * Only retrieve invoices that have this client ID associated with them.
+   * Matched exactly, so order IDs like #1001 can be passed as they are.
+   */
+  client_id?: string
 }
 
 export const Invoices_List = async (
@@ -2310,7 +4557,8 @@ export const Invoices_List = async (
   paid_before_expiry?: boolean,
   expired?: boolean,
   sort: 'DESCENDING' | 'ASCENDING' = 'DESCENDING',
-  sort_by: 'CREATE_TIME' | 'STATUS' | 'AMOUNT' = 'CREATE_TIME'
+  sort_by: 'CREATE_TIME' | 'STATUS' | 'AMOUNT' = 'CREATE_TIME',
+  client_id?: string
 ): Promise<InvoiceList> => {
   try {
     const response = await api.get(
@@ -2329,113 +4577,761 @@ export const Invoices_List = async (
         ['paid_before_expiry', paid_before_expiry],
         ['expired', expired],
         ['sort', sort],
-        ['sort_by', sort_by]
+        ['sort_by', sort_by],
+        ['client_id', client_id]
       )
     )
     return response.data as InvoiceList
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export interface StatsAmountTransactedQueryParams {
+export interface InvoicesGetProofQueryParams {
   /**
-   * The earliest transaction that should be included. If not set, includes
-   * everything up to end time.
+   * The Teslacoil UUID of the invoice you want a proof of payment for.
    */
-  start_time?: string
+  id?: string
+}
+
+export const Invoices_GetProof = async (id?: string): Promise<InvoiceProof> => {
+  try {
+    const response = await api.get(buildURL('/v0/invoices/proof', ['id', id]))
+    return response.data as InvoiceProof
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Invoices_VerifyPreimage = async (req: VerifyPreimageRequest): Promise<VerifyPreimageResponse> => {
+  try {
+    const response = await api.post('/v0/invoices/verify', req)
+    return response.data as VerifyPreimageResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface InvoicesListByClientIdQueryParams {
   /**
-   * The latest transaction that should be included. If not set, includes
-   * everything from start time.
+   * The client ID the invoices were created with, e.g. a Shopify order name
+   * like #1001.
+   */
+  client_id?: string
+}
+
+export const Invoices_ListByClientId = async (client_id?: string): Promise<OrderInvoicesResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/invoices/order', ['client_id', client_id]))
+    return response.data as OrderInvoicesResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface PaymentRequestsGetQueryParams {
+  /**
+   * The Teslacoil ID of the payment request you want to retrieve.
+   */
+  id?: string
+}
+
+export const PaymentRequests_Get = async (id?: string): Promise<PaymentRequest> => {
+  try {
+    const response = await api.get(buildURL('/v0/payment_requests', ['id', id]))
+    return response.data as PaymentRequest
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const PaymentRequests_Create = async (req: CreatePaymentRequestRequest): Promise<PaymentRequest> => {
+  try {
+    const response = await api.post('/v0/payment_requests', req)
+    return response.data as PaymentRequest
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface PaymentRequestsCancelQueryParams {
+  /**
+   * The Teslacoil ID of the payment request you want to cancel.
+   */
+  id?: string
+}
+
+export const PaymentRequests_Cancel = async (id?: string): Promise<PaymentRequest> => {
+  try {
+    const response = await api.delete(buildURL('/v0/payment_requests', ['id', id]))
+    return response.data as PaymentRequest
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const PaymentRequests_Resend = async (req: ResendPaymentRequestRequest): Promise<PaymentRequest> => {
+  try {
+    const response = await api.post('/v0/payment_requests/resend', req)
+    return response.data as PaymentRequest
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface PaymentRequestsListQueryParams {
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many payment requests to fetch. Together with specifying an offset,
+   * allows for implementation of pagination.
+   */
+  limit?: number
+}
+
+export const PaymentRequests_List = async (offset?: number, limit?: number): Promise<ListPaymentRequestsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/payment_requests/list', ['offset', offset], ['limit', limit]))
+    return response.data as ListPaymentRequestsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Payments_CreateUnified = async (req: CreateUnifiedPaymentRequest): Promise<UnifiedPayment> => {
+  try {
+    const response = await api.post('/v0/payments/unified', req)
+    return response.data as UnifiedPayment
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface PaymentsGetUnifiedQueryParams {
+  /**
+   * The Teslacoil ID of the unified payment you want to retrieve.
+   */
+  id?: string
+}
+
+export const Payments_GetUnified = async (id?: string): Promise<UnifiedPayment> => {
+  try {
+    const response = await api.get(buildURL('/v0/payments/unified', ['id', id]))
+    return response.data as UnifiedPayment
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Payouts_GetSchedule = async (): Promise<PayoutSchedule> => {
+  try {
+    const response = await api.get(buildURL('/v0/payouts/schedule'))
+    return response.data as PayoutSchedule
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Payouts_UpdateSchedule = async (req: UpdatePayoutScheduleRequest): Promise<PayoutSchedule> => {
+  try {
+    const response = await api.put('/v0/payouts/schedule', req)
+    return response.data as PayoutSchedule
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface PayoutsGetQueryParams {
+  /**
+   * The Teslacoil ID of the payout you want to retrieve.
+   */
+  id?: string
+}
+
+export const Payouts_Get = async (id?: string): Promise<Payout> => {
+  try {
+    const response = await api.get(buildURL('/v0/payouts', ['id', id]))
+    return response.data as Payout
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface PayoutsListQueryParams {
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many payouts to fetch. Together with specifying an offset, allows for
+   * implementation of pagination.
+   */
+  limit?: number
+}
+
+export const Payouts_List = async (offset?: number, limit?: number): Promise<ListPayoutsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/payouts/list', ['offset', offset], ['limit', limit]))
+    return response.data as ListPayoutsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Payouts_VerifyDestination = async (req: VerifyPayoutDestinationRequest): Promise<PayoutSchedule> => {
+  try {
+    const response = await api.post('/v0/payouts/schedule/verify', req)
+    return response.data as PayoutSchedule
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface StatsAmountTransactedQueryParams {
+  /**
+   * The earliest transaction that should be included. If not set, includes
+   * everything up to end time.
+   */
+  start_time?: string
+  /**
+   * The latest transaction that should be included. If not set, includes
+   * everything from start time.
+   */
+  end_time?: string
+  /**
+   * The currency you want the response fiat amount to be denominated in.
+   */
+  currency?: 'GBP' | 'NOK' | 'USD' | 'EUR'
+}
+
+export const Stats_AmountTransacted = async (
+  start_time?: string,
+  end_time?: string,
+  currency?: string
+): Promise<AmountTransactedResponse> => {
+  try {
+    const response = await api.get(
+      buildURL(
+        '/v0/stats/amount_transacted',
+        ['start_time', start_time],
+        ['end_time', end_time],
+        ['currency', currency]
+      )
+    )
+    return response.data as AmountTransactedResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Stats_RecentEvents = async (): Promise<RecentEventsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/stats/recent_events'))
+    return response.data as RecentEventsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface StatsUsageQueryParams {
+  /**
+   * The earliest event that should be included. If not set, includes everything
+   * up to end time.
+   */
+  start_time?: string
+  /**
+   * The latest event that should be included. If not set, includes everything
+   * from start time.
+   */
+  end_time?: string
+}
+
+export const Stats_Usage = async (start_time?: string, end_time?: string): Promise<UsageResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/stats/usage', ['start_time', start_time], ['end_time', end_time]))
+    return response.data as UsageResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface StatsAdminQueryParams {
+  /**
+   * The start of the period to compute statistics for.
+   */
+  start_time?: string
+  /**
+   * The end of the period to compute statistics for.
+   */
+  end_time?: string
+  /**
+   * The size of each interval in the time series. Defaults to DAY.
+   *
+   *  - HOUR: One data point per hour
+   *  - DAY: One data point per day
+   *  - WEEK: One data point per week
+   */
+  interval?: 'HOUR' | 'DAY' | 'WEEK'
+}
+
+export const Stats_Admin = async (
+  start_time?: string,
+  end_time?: string,
+  interval: 'HOUR' | 'DAY' | 'WEEK' = 'DAY'
+): Promise<AdminStatsResponse> => {
+  try {
+    const response = await api.get(
+      buildURL('/v0/stats/admin', ['start_time', start_time], ['end_time', end_time], ['interval', interval])
+    )
+    return response.data as AdminStatsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SupportGetUserQueryParams {
+  /**
+   * The ID of the user to retrieve. Only available to support agents and
+   * admins, and recorded in the audit log.
+   */
+  user_id?: string
+}
+
+export const Support_GetUser = async (user_id?: string): Promise<User> => {
+  try {
+    const response = await api.get(buildURL('/v0/support/user', ['user_id', user_id]))
+    return response.data as User
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SupportListTransactionsQueryParams {
+  /**
+   * The ID of the user to retrieve transactions for. Only available to
+   * support agents and admins, and recorded in the audit log.
+   */
+  user_id?: string
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many transactions to fetch. Together with specifying an offset, allows
+   * for implementation of pagination.
+   */
+  limit?: number
+  /**
+   * An opaque cursor returned as the next cursor of a previous response. If
+   * set, retrieves the page following that response, and offset must not be
+   * set. Pass it exactly as received, it is URL encoded when sent.
+   */
+  cursor?: string
+}
+
+export const Support_ListTransactions = async (
+  user_id?: string,
+  offset?: number,
+  limit?: number,
+  cursor?: string
+): Promise<TxListResponse> => {
+  try {
+    const response = await api.get(
+      buildURL(
+        '/v0/support/transactions',
+        ['user_id', user_id],
+        ['offset', offset],
+        ['limit', limit],
+        ['cursor', cursor]
+      )
+    )
+    return response.data as TxListResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_GetLogLevels = async (): Promise<LogLevels> => {
+  try {
+    const response = await api.get(buildURL('/v0/system/log'))
+    return response.data as LogLevels
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_SetLogLevels = async (req: SetLogLevelsRequest): Promise<LogLevels> => {
+  try {
+    const response = await api.patch('/v0/system/log', req)
+    return response.data as LogLevels
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SystemPingResponse {}
+
+export const System_Ping = async (): Promise<{}> => {
+  try {
+    const response = await api.get(buildURL('/v0/system/ping'))
+    return response.data as {}
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_GetBalanceLevels = async (): Promise<BalanceLevelsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/system/balances'))
+    return response.data as BalanceLevelsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SystemListAuditChangesQueryParams {
+  /**
+   * Only retrieve changes to this type of entity.
+   */
+  entity_type?: 'TRANSACTION' | 'USER' | 'API_KEY'
+  /**
+   * Only retrieve changes to the entity with this ID.
+   */
+  entity_id?: string
+  /**
+   * Only retrieve changes made at or after this time.
+   */
+  start_time?: string
+  /**
+   * Only retrieve changes made at or before this time.
+   */
+  end_time?: string
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many changes to fetch. Together with specifying an offset, allows for
+   * implementation of pagination.
+   */
+  limit?: number
+}
+
+export const System_ListAuditChanges = async (
+  entity_type?: string,
+  entity_id?: string,
+  start_time?: string,
+  end_time?: string,
+  offset?: number,
+  limit?: number
+): Promise<ListAuditChangesResponse> => {
+  try {
+    const response = await api.get(
+      buildURL(
+        '/v0/system/audit/changes',
+        ['entity_type', entity_type],
+        ['entity_id', entity_id],
+        ['start_time', start_time],
+        ['end_time', end_time],
+        ['offset', offset],
+        ['limit', limit]
+      )
+    )
+    return response.data as ListAuditChangesResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_BumpDeposit = async (req: BumpDepositRequest): Promise<BumpDepositResponse> => {
+  try {
+    const response = await api.post('/v0/system/cpfp', req)
+    return response.data as BumpDepositResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_ListUnsignedWithdrawals = async (): Promise<ListUnsignedWithdrawalsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/system/psbt/list'))
+    return response.data as ListUnsignedWithdrawalsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_SubmitSignedWithdrawal = async (req: SubmitSignedWithdrawalRequest): Promise<TxOnchain> => {
+  try {
+    const response = await api.post('/v0/system/psbt', req)
+    return response.data as TxOnchain
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SystemListUtxosQueryParams {
+  /**
+   * Only list outputs with at least this many confirmations.
+   */
+  min_confirmations?: number
+}
+
+export const System_ListUtxos = async (min_confirmations?: number): Promise<ListUtxosResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/system/utxos', ['min_confirmations', min_confirmations]))
+    return response.data as ListUtxosResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_Live = async (): Promise<HealthResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/system/health/live'))
+    return response.data as HealthResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_Ready = async (): Promise<HealthResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/system/health/ready'))
+    return response.data as HealthResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SystemListAuditLogQueryParams {
+  /**
+   * Only retrieve entries for actions performed by this user.
+   */
+  user_id?: string
+  /**
+   * Only retrieve entries for this action.
+   */
+  action?:
+    | 'LOGIN'
+    | 'PASSWORD_CHANGE'
+    | 'TWO_FACTOR_CHANGE'
+    | 'API_KEY_CREATE'
+    | 'API_KEY_DELETE'
+    | 'WITHDRAWAL'
+    | 'ADMIN_ACTION'
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many entries to fetch. Together with specifying an offset, allows for
+   * implementation of pagination.
+   */
+  limit?: number
+}
+
+export const System_ListAuditLog = async (
+  user_id?: string,
+  action?: string,
+  offset?: number,
+  limit?: number
+): Promise<ListAuditLogResponse> => {
+  try {
+    const response = await api.get(
+      buildURL('/v0/system/audit/log', ['user_id', user_id], ['action', action], ['offset', offset], ['limit', limit])
+    )
+    return response.data as ListAuditLogResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SystemListQueuedEmailsQueryParams {
+  /**
+   * Only retrieve emails with this status.
+   *
+   *  - QUEUED: The email is waiting to be sent, or to be retried
+   *  - SENT: The email was accepted by the email provider
+   *  - FAILED: The email could not be sent, and will not be retried
+   */
+  status?: 'QUEUED' | 'SENT' | 'FAILED'
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many emails to fetch. Together with specifying an offset, allows for
+   * implementation of pagination.
+   */
+  limit?: number
+}
+
+export const System_ListQueuedEmails = async (
+  status?: string,
+  offset?: number,
+  limit?: number
+): Promise<ListQueuedEmailsResponse> => {
+  try {
+    const response = await api.get(
+      buildURL('/v0/system/emails', ['status', status], ['offset', offset], ['limit', limit])
+    )
+    return response.data as ListQueuedEmailsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const System_ImportBlocklist = async (req: ImportBlocklistRequest): Promise<ImportBlocklistResponse> => {
+  try {
+    const response = await api.post('/v0/system/blocklist/import', req)
+    return response.data as ImportBlocklistResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface SystemListBlocklistQueryParams {
+  /**
+   * Only retrieve the entry for this address or node public key.
+   */
+  address?: string
+  /**
+   * Only retrieve entries imported from this list.
+   */
+  source?: string
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
    */
-  end_time?: string
+  offset?: number
   /**
-   * The currency you want the response fiat amount to be denominated in.
+   * How many entries to fetch. Together with specifying an offset, allows for
+   * implementation of pagination.
    */
-  currency?: 'GBP' | 'NOK' | 'USD' | 'EUR'
+  limit?: number
 }
 
-export const Stats_AmountTransacted = async (
-  start_time?: string,
-  end_time?: string,
-  currency?: string
-): Promise<AmountTransactedResponse> => {
+export const System_ListBlocklist = async (
+  address?: string,
+  source?: string,
+  offset?: number,
+  limit?: number
+): Promise<ListBlocklistResponse> => {
   try {
     const response = await api.get(
-      buildURL(
-        '/v0/stats/amount_transacted',
-        ['start_time', start_time],
-        ['end_time', end_time],
-        ['currency', currency]
-      )
+      buildURL('/v0/system/blocklist', ['address', address], ['source', source], ['offset', offset], ['limit', limit])
     )
-    return response.data as AmountTransactedResponse
+    return response.data as ListBlocklistResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export const Stats_RecentEvents = async (): Promise<RecentEventsResponse> => {
+export interface SystemSetSupportAgentResponse {}
+
+export const System_SetSupportAgent = async (req: SetSupportAgentRequest): Promise<{}> => {
   try {
-    const response = await api.get(buildURL('/v0/stats/recent_events'))
-    return response.data as RecentEventsResponse
+    const response = await api.put('/v0/system/support_agents', req)
+    return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export interface StatsUsageQueryParams {
-  /**
-   * The earliest event that should be included. If not set, includes everything
-   * up to end time.
-   */
-  start_time?: string
+export interface SystemShutdownResponse {}
+
+export interface TenantsGetQueryParams {
   /**
-   * The latest event that should be included. If not set, includes everything
-   * from start time.
+   * The Teslacoil ID of the tenant you want to retrieve.
    */
-  end_time?: string
+  id?: string
 }
 
-export const Stats_Usage = async (start_time?: string, end_time?: string): Promise<UsageResponse> => {
+export const Tenants_Get = async (id?: string): Promise<Tenant> => {
   try {
-    const response = await api.get(buildURL('/v0/stats/usage', ['start_time', start_time], ['end_time', end_time]))
-    return response.data as UsageResponse
+    const response = await api.get(buildURL('/v0/tenants', ['id', id]))
+    return response.data as Tenant
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export const System_GetLogLevels = async (): Promise<LogLevels> => {
+export const Tenants_Create = async (req: CreateTenantRequest): Promise<Tenant> => {
   try {
-    const response = await api.get(buildURL('/v0/system/log'))
-    return response.data as LogLevels
+    const response = await api.post('/v0/tenants', req)
+    return response.data as Tenant
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export const System_SetLogLevels = async (req: SetLogLevelsRequest): Promise<LogLevels> => {
+export const Tenants_Update = async (req: UpdateTenantRequest): Promise<Tenant> => {
   try {
-    const response = await api.patch('/v0/system/log', req)
-    return response.data as LogLevels
+    const response = await api.put('/v0/tenants', req)
+    return response.data as Tenant
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export interface SystemPingResponse {}
+export const Tenants_List = async (): Promise<ListTenantsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/tenants/list'))
+    return response.data as ListTenantsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
 
-export const System_Ping = async (): Promise<{}> => {
+export const Terminals_Pair = async (req: PairTerminalRequest): Promise<PairTerminalResponse> => {
   try {
-    const response = await api.get(buildURL('/v0/system/ping'))
-    return response.data as {}
+    const response = await api.post('/v0/terminals', req)
+    return response.data as PairTerminalResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
-export interface SystemShutdownResponse {}
+export interface TerminalsRevokeQueryParams {
+  /**
+   * The Teslacoil ID of the terminal you want to revoke.
+   */
+  id?: string
+}
+
+export const Terminals_Revoke = async (id?: string): Promise<Terminal> => {
+  try {
+    const response = await api.delete(buildURL('/v0/terminals', ['id', id]))
+    return response.data as Terminal
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface TerminalsListQueryParams {
+  /**
+   * Only list terminals belonging to this store.
+   */
+  store_id?: string
+}
+
+export const Terminals_List = async (store_id?: string): Promise<ListTerminalsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/terminals/list', ['store_id', store_id]))
+    return response.data as ListTerminalsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
 
 export interface TeslaPayGetDepositQueryParams {
   /**
@@ -2453,7 +5349,7 @@ export const TeslaPay_GetDeposit = async (id?: string, client_id?: string): Prom
     const response = await api.get(buildURL('/v0/teslapay/deposit', ['id', id], ['client_id', client_id]))
     return response.data as TeslaPayDeposit
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2469,7 +5365,7 @@ export const TeslaPay_GetWithdrawal = async (id?: string): Promise<TeslaPayWithd
     const response = await api.get(buildURL('/v0/teslapay/withdrawal', ['id', id]))
     return response.data as TeslaPayWithdrawal
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2489,7 +5385,32 @@ export const Transactions_GetTransaction = async (id?: string, client_id?: strin
     const response = await api.get(buildURL('/v0/transactions', ['id', id], ['client_id', client_id]))
     return response.data as TxTransaction
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Transactions_UpdateTransaction = async (req: UpdateTransactionRequest): Promise<TxTransaction> => {
+  try {
+    const response = await api.patch('/v0/transactions', req)
+    return response.data as TxTransaction
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface TransactionsListEditsQueryParams {
+  /**
+   * The Teslacoil ID of the transaction you want the edit history for.
+   */
+  id?: string
+}
+
+export const Transactions_ListEdits = async (id?: string): Promise<ListTransactionEditsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/transactions/edits', ['id', id]))
+    return response.data as ListTransactionEditsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2511,7 +5432,7 @@ export const Transactions_GetLightning = async (id?: string, lightning_request?:
     )
     return response.data as TxLightning
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2529,7 +5450,7 @@ export const Transactions_DecodeLightning = async (lightning_request?: string):
     )
     return response.data as DecodeLightningResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2538,7 +5459,7 @@ export const Transactions_SendLightning = async (req: TxSendLightningRequest): P
     const response = await api.post('/v0/transactions/lightning/send', req)
     return response.data as TxSendResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2608,12 +5529,39 @@ export interface TransactionsListTransactionsQueryParams {
    * failed yet
    *  - COMPLETED: The transaction has been received by the recipient, and is settled.
    *  - FAILED: The transaction has failed
+   *  - PENDING_APPROVAL: The withdrawal is above the approval threshold, and is waiting
+   * for a second admin to approve it before it is sent
+   *  - SCHEDULED: The withdrawal is waiting for its execute time or fee rate condition
+   *  - CANCELLED: The withdrawal was cancelled before it was broadcast, and the amount
+   * was returned to the account balance
    */
-  statuses?: ('PENDING' | 'COMPLETED' | 'FAILED')[]
+  statuses?: ('PENDING' | 'COMPLETED' | 'FAILED' | 'PENDING_APPROVAL' | 'SCHEDULED' | 'CANCELLED')[]
   /**
    * include transactions made as part of trading settlements.
    */
   include_settlements?: boolean
+  /**
+   * Only retrieve transactions for invoices created by this point-of-sale
+   * terminal.
+   */
+  terminal_id?: string
+  /**
+   * Only retrieve transactions that have this client ID associated with them.
+   * Matched exactly, so order IDs like #1001 can be passed as they are.
+   */
+  client_id?: string
+  /**
+   * An opaque cursor returned as the next cursor of a previous response. If
+   * set, retrieves the page following that response, and offset must not be
+   * set. This is much faster than using an offset for large result sets. Pass
+   * it exactly as received, it is URL encoded when sent.
+   */
+  cursor?: string
+  /**
+   * Only retrieve transactions that have all of the provided labels. Sent as a
+   * comma separated list, with each label URL encoded.
+   */
+  labels?: string[]
 }
 
 export const Transactions_ListTransactions = async (
@@ -2627,8 +5575,12 @@ export const Transactions_ListTransactions = async (
   sort: 'DESCENDING' | 'ASCENDING' = 'DESCENDING',
   sort_by: 'CREATE_TIME' | 'STATUS' | 'AMOUNT' = 'CREATE_TIME',
   network_type?: string,
-  statuses?: ('PENDING' | 'COMPLETED' | 'FAILED')[],
-  include_settlements?: boolean
+  statuses?: ('PENDING' | 'COMPLETED' | 'FAILED' | 'PENDING_APPROVAL' | 'SCHEDULED' | 'CANCELLED')[],
+  include_settlements?: boolean,
+  terminal_id?: string,
+  client_id?: string,
+  cursor?: string,
+  labels?: string[]
 ): Promise<TxListResponse> => {
   try {
     const response = await api.get(
@@ -2645,12 +5597,16 @@ export const Transactions_ListTransactions = async (
         ['sort_by', sort_by],
         ['network_type', network_type],
         ['statuses', statuses],
-        ['include_settlements', include_settlements]
+        ['include_settlements', include_settlements],
+        ['terminal_id', terminal_id],
+        ['client_id', client_id],
+        ['cursor', cursor],
+        ['labels', labels]
       )
     )
     return response.data as TxListResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2680,7 +5636,7 @@ export const Transactions_GetOnchain = async (
     )
     return response.data as TxOnchain
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2689,7 +5645,24 @@ export const Transactions_SendOnchain = async (req: TxSendOnchainRequest): Promi
     const response = await api.post('/v0/transactions/onchain/send', req)
     return response.data as TxSendResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface TransactionsCancelOnchainQueryParams {
+  /**
+   * The Teslacoil ID of the withdrawal you want to cancel. Only withdrawals
+   * that are not yet broadcast can be cancelled.
+   */
+  id?: string
+}
+
+export const Transactions_CancelOnchain = async (id?: string): Promise<TxOnchain> => {
+  try {
+    const response = await api.delete(buildURL('/v0/transactions/onchain', ['id', id]))
+    return response.data as TxOnchain
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2707,7 +5680,7 @@ export const Transactions_CancelPreparedTransaction = async (id?: string): Promi
     const response = await api.delete(buildURL('/v0/transactions/prepare', ['id', id]))
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2723,7 +5696,7 @@ export const Transactions_GetPreparedTransaction = async (id?: string): Promise<
     const response = await api.get(buildURL('/v0/transactions/prepare', ['id', id]))
     return response.data as Preparation
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2732,7 +5705,7 @@ export const Transactions_PrepareTransaction = async (req: PrepareRequest): Prom
     const response = await api.post('/v0/transactions/prepare', req)
     return response.data as Preparation
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2741,7 +5714,7 @@ export const Transactions_ExecutePreparedTransaction = async (req: ExecuteReques
     const response = await api.post('/v0/transactions/prepare/execute', req)
     return response.data as Preparation
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2759,7 +5732,7 @@ export const Transactions_GetLnUrlPreparedTransaction = async (
     const response = await api.get(buildURL('/v0/transactions/prepare/lnurl', ['secret', secret]))
     return response.data as LnurlGetWithdrawalResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2783,7 +5756,148 @@ export const Transactions_ExecuteLnUrlPreparedTransaction = async (
     const response = await api.get(buildURL('/v0/transactions/prepare/lnurl/execute', ['k1', k1], ['pr', pr]))
     return response.data as LnurlExecuteWithdrawalResponse
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Transactions_FlagTransaction = async (req: FlagTransactionRequest): Promise<Dispute> => {
+  try {
+    const response = await api.post('/v0/transactions/dispute', req)
+    return response.data as Dispute
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Transactions_ResolveDispute = async (req: ResolveDisputeRequest): Promise<Dispute> => {
+  try {
+    const response = await api.put('/v0/transactions/dispute', req)
+    return response.data as Dispute
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface TransactionsListDisputesQueryParams {
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many disputes to fetch. Together with specifying an offset, allows for
+   * implementation of pagination.
+   */
+  limit?: number
+  /**
+   * Include disputes having one of the provided statuses. If no statuses are
+   * set, no filter is applied.
+   *
+   *  - OPEN: The dispute is waiting to be reviewed by support
+   *  - ESCALATED: The dispute needs further investigation
+   *  - CREDITED: The dispute was resolved in favor of the account, and the
+   * account was credited
+   *  - REJECTED: The dispute was resolved without crediting the account
+   */
+  statuses?: ('OPEN' | 'ESCALATED' | 'CREDITED' | 'REJECTED')[]
+}
+
+export const Transactions_ListDisputes = async (
+  offset?: number,
+  limit?: number,
+  statuses?: ('OPEN' | 'ESCALATED' | 'CREDITED' | 'REJECTED')[]
+): Promise<ListDisputesResponse> => {
+  try {
+    const response = await api.get(
+      buildURL('/v0/transactions/dispute/list', ['offset', offset], ['limit', limit], ['statuses', statuses])
+    )
+    return response.data as ListDisputesResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Transactions_ApproveWithdrawal = async (req: ApproveWithdrawalRequest): Promise<TxTransaction> => {
+  try {
+    const response = await api.post('/v0/transactions/approve', req)
+    return response.data as TxTransaction
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface TransactionsSearchTransactionsQueryParams {
+  /**
+   * The text to search for. Matches the description, memo, client ID, address
+   * and network ID of transactions. May contain any characters, including
+   * spaces, & and #.
+   */
+  q?: string
+  /**
+   * The offset into the result set to retrieve from. Combined with specifying a
+   * limit, allows for implementation of pagination.
+   */
+  offset?: number
+  /**
+   * How many transactions to fetch. Together with specifying an offset, allows
+   * for implementation of pagination.
+   */
+  limit?: number
+  /**
+   * An opaque cursor returned as the next cursor of a previous response. If
+   * set, retrieves the page following that response, and offset must not be
+   * set. Pass it exactly as received, it is URL encoded when sent.
+   */
+  cursor?: string
+}
+
+export const Transactions_SearchTransactions = async (
+  q?: string,
+  offset?: number,
+  limit?: number,
+  cursor?: string
+): Promise<TxListResponse> => {
+  try {
+    const response = await api.get(
+      buildURL('/v0/transactions/search', ['q', q], ['offset', offset], ['limit', limit], ['cursor', cursor])
+    )
+    return response.data as TxListResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Transactions_SetLabels = async (req: SetTransactionLabelsRequest): Promise<TxTransaction> => {
+  try {
+    const response = await api.put('/v0/transactions/labels', req)
+    return response.data as TxTransaction
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Transactions_RetryCallback = async (req: RetryCallbackRequest): Promise<CallbackAttempt> => {
+  try {
+    const response = await api.post('/v0/transactions/callback/retry', req)
+    return response.data as CallbackAttempt
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface TransactionsListCallbacksQueryParams {
+  /**
+   * The Teslacoil ID of the transaction you want the callback history for.
+   */
+  id?: string
+}
+
+export const Transactions_ListCallbacks = async (id?: string): Promise<ListCallbackAttemptsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/transactions/callbacks', ['id', id]))
+    return response.data as ListCallbackAttemptsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2792,7 +5906,7 @@ export const Users_CreateUser = async (req: CreateUserRequest): Promise<User> =>
     const response = await api.post('/v0/users', req)
     return response.data as User
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
   }
 }
 
@@ -2803,6 +5917,105 @@ export const Users_UpdateUser = async (req: UpdateUserRequest): Promise<{}> => {
     const response = await api.put('/v0/users', req)
     return response.data as {}
   } catch (error) {
-    throw Error(error)
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface UsersDeleteUserResponse {}
+
+export const Users_DeleteUser = async (req: DeleteUserRequest): Promise<{}> => {
+  try {
+    const response = await api.post('/v0/users/delete', req)
+    return response.data as {}
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface UsersExportDataRequestBody {}
+
+export const Users_ExportData = async (): Promise<DataExport> => {
+  try {
+    const response = await api.post('/v0/users/export')
+    return response.data as DataExport
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface UsersGetDataExportQueryParams {
+  /**
+   * The ID of the export job.
+   */
+  id?: string
+}
+
+export const Users_GetDataExport = async (id?: string): Promise<DataExport> => {
+  try {
+    const response = await api.get(buildURL('/v0/users/export', ['id', id]))
+    return response.data as DataExport
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Webhooks_CreateEndpoint = async (req: CreateWebhookEndpointRequest): Promise<WebhookSecretResponse> => {
+  try {
+    const response = await api.post('/v0/webhooks', req)
+    return response.data as WebhookSecretResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export interface WebhooksDeleteEndpointQueryParams {
+  /**
+   * The ID of the endpoint to delete.
+   */
+  id?: string
+}
+
+export const Webhooks_DeleteEndpoint = async (id?: string): Promise<WebhookEndpoint> => {
+  try {
+    const response = await api.delete(buildURL('/v0/webhooks', ['id', id]))
+    return response.data as WebhookEndpoint
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Webhooks_ListEndpoints = async (): Promise<ListWebhookEndpointsResponse> => {
+  try {
+    const response = await api.get(buildURL('/v0/webhooks/list'))
+    return response.data as ListWebhookEndpointsResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Webhooks_RotateSecret = async (req: RotateWebhookSecretRequest): Promise<WebhookSecretResponse> => {
+  try {
+    const response = await api.post('/v0/webhooks/rotate', req)
+    return response.data as WebhookSecretResponse
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Webhooks_Test = async (req: TestWebhookRequest): Promise<CallbackAttempt> => {
+  try {
+    const response = await api.post('/v0/webhooks/test', req)
+    return response.data as CallbackAttempt
+  } catch (error) {
+    throw new TeslacoilError(error)
+  }
+}
+
+export const Widgets_CreateConfig = async (req: CreateWidgetConfigRequest): Promise<WidgetConfig> => {
+  try {
+    const response = await api.post('/v0/widgets/config', req)
+    return response.data as WidgetConfig
+  } catch (error) {
+    throw new TeslacoilError(error)
   }
 }